@@ -12,18 +12,40 @@ import (
 var _ StoreInterface = (*Store)(nil) // verify it extends the interface
 
 type Store struct {
-	categoryTableName      string
-	discountTableName      string
-	mediaTableName         string
-	orderTableName         string
-	orderLineItemTableName string
-	productTableName       string
-	db                     *sql.DB
-	dbDriverName           string
-	timeoutSeconds         int64
-	automigrateEnabled     bool
-	debugEnabled           bool
-	sqlLogger              *slog.Logger
+	categoryTableName           string
+	discountTableName           string
+	discountRedemptionTableName string
+	mediaTableName              string
+	orderTableName              string
+	orderLineItemTableName      string
+	orderStatusHistoryTableName string
+	productTableName            string
+	productCategoryTableName    string
+	productPriceTableName       string
+	taxRateTableName            string
+	shippingMethodTableName     string
+	shipmentTableName           string
+	shipmentLineTableName       string
+	refundTableName             string
+	paymentTableName            string
+	customerTableName           string
+	addressTableName            string
+	cartTableName               string
+	cartItemTableName           string
+	stockReservationTableName   string
+	productOptionTableName      string
+	productOptionValueTableName string
+	productVariantTableName     string
+	slugRedirectTableName       string
+	productAttributeTableName   string
+	orderStatusTransitions      map[string][]string
+	db                          *sql.DB
+	dbDriverName                string
+	timeoutSeconds              int64
+	automigrateEnabled          bool
+	productSearchFullText       bool
+	debugEnabled                bool
+	sqlLogger                   *slog.Logger
 }
 
 // logSql logs sql to the sql logger
@@ -37,15 +59,36 @@ func (store *Store) logSql(sqlOperationType string, sql string, params ...interf
 	}
 }
 
-// AutoMigrate auto migrate
+// AutoMigrate creates the tables, and adds the columns and indexes added
+// since to the tables created before (see tableColumnsAdded)
 func (store *Store) AutoMigrate() error {
 	sqls := []string{
 		store.sqlCategoryTableCreate(),
 		store.sqlDiscountTableCreate(),
+		store.sqlDiscountRedemptionTableCreate(),
 		store.sqlMediaTableCreate(),
 		store.sqlOrderTableCreate(),
 		store.sqlOrderLineItemTableCreate(),
+		store.sqlOrderStatusHistoryTableCreate(),
 		store.sqlProductTableCreate(),
+		store.sqlProductCategoryTableCreate(),
+		store.sqlProductPriceTableCreate(),
+		store.sqlTaxRateTableCreate(),
+		store.sqlShippingMethodTableCreate(),
+		store.sqlShipmentTableCreate(),
+		store.sqlShipmentLineTableCreate(),
+		store.sqlRefundTableCreate(),
+		store.sqlPaymentTableCreate(),
+		store.sqlCustomerTableCreate(),
+		store.sqlAddressTableCreate(),
+		store.sqlCartTableCreate(),
+		store.sqlCartItemTableCreate(),
+		store.sqlStockReservationTableCreate(),
+		store.sqlProductOptionTableCreate(),
+		store.sqlProductOptionValueTableCreate(),
+		store.sqlProductVariantTableCreate(),
+		store.sqlSlugRedirectTableCreate(),
+		store.sqlProductAttributeTableCreate(),
 	}
 
 	for _, sql := range sqls {
@@ -56,6 +99,21 @@ func (store *Store) AutoMigrate() error {
 		}
 	}
 
+	// Tables created before keep up with the columns and indexes added
+	migrations := []func() error{
+		store.tableColumnsMigrate,
+		store.slugsMigrate,
+		store.tableIndexesMigrate,
+		store.productSearchMigrate,
+	}
+
+	for _, migrate := range migrations {
+		if err := migrate(); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -85,6 +143,10 @@ func (store *Store) DiscountTableName() string {
 	return store.discountTableName
 }
 
+func (store *Store) DiscountRedemptionTableName() string {
+	return store.discountRedemptionTableName
+}
+
 func (store *Store) MediaTableName() string {
 	return store.mediaTableName
 }
@@ -97,10 +159,117 @@ func (store *Store) OrderLineItemTableName() string {
 	return store.orderLineItemTableName
 }
 
+func (store *Store) OrderStatusHistoryTableName() string {
+	return store.orderStatusHistoryTableName
+}
+
 func (store *Store) ProductTableName() string {
 	return store.productTableName
 }
 
+func (store *Store) ProductCategoryTableName() string {
+	return store.productCategoryTableName
+}
+
+func (store *Store) ProductPriceTableName() string {
+	return store.productPriceTableName
+}
+
+func (store *Store) TaxRateTableName() string {
+	return store.taxRateTableName
+}
+
+func (store *Store) ShippingMethodTableName() string {
+	return store.shippingMethodTableName
+}
+
+func (store *Store) ShipmentTableName() string {
+	return store.shipmentTableName
+}
+
+func (store *Store) ShipmentLineTableName() string {
+	return store.shipmentLineTableName
+}
+
+func (store *Store) RefundTableName() string {
+	return store.refundTableName
+}
+
+func (store *Store) PaymentTableName() string {
+	return store.paymentTableName
+}
+
+func (store *Store) CustomerTableName() string {
+	return store.customerTableName
+}
+
+func (store *Store) AddressTableName() string {
+	return store.addressTableName
+}
+
+func (store *Store) CartTableName() string {
+	return store.cartTableName
+}
+
+func (store *Store) CartItemTableName() string {
+	return store.cartItemTableName
+}
+
+func (store *Store) StockReservationTableName() string {
+	return store.stockReservationTableName
+}
+
+func (store *Store) ProductOptionTableName() string {
+	return store.productOptionTableName
+}
+
+func (store *Store) ProductOptionValueTableName() string {
+	return store.productOptionValueTableName
+}
+
+func (store *Store) ProductVariantTableName() string {
+	return store.productVariantTableName
+}
+
+func (store *Store) SlugRedirectTableName() string {
+	return store.slugRedirectTableName
+}
+
+func (store *Store) ProductAttributeTableName() string {
+	return store.productAttributeTableName
+}
+
+// transaction runs fn inside a database transaction, committing it if fn
+// succeeds and rolling it back otherwise. If the context already carries
+// a transaction, fn joins it and committing is left to the outer caller.
+func (store *Store) transaction(ctx context.Context, fn func(txCtx database.QueryableContext) error) (err error) {
+	if database.IsQueryableContext(ctx) && ctx.(database.QueryableContext).IsTx() {
+		return fn(ctx.(database.QueryableContext))
+	}
+
+	tx, err := store.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback()
+			panic(r)
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	return fn(database.Context(ctx, tx))
+}
+
 func (store *Store) toQuerableContext(context context.Context) database.QueryableContext {
 	if database.IsQueryableContext(context) {
 		return context.(database.QueryableContext)
@@ -39,6 +39,60 @@ func initStore(filepath string) (StoreInterface, error) {
 		return nil, err
 	}
 
+	store, err := NewStore(initStoreOptions(db))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if store == nil {
+		return nil, errors.New("unexpected nil store")
+	}
+
+	return store, nil
+}
+
+func initStoreOptions(db *sql.DB) NewStoreOptions {
+	return NewStoreOptions{
+		DB:                          db,
+		CategoryTableName:           "shop_category",
+		DiscountTableName:           "shop_discount",
+		DiscountRedemptionTableName: "shop_discount_redemption",
+		MediaTableName:              "shop_media",
+		OrderTableName:              "shop_order",
+		OrderLineItemTableName:      "shop_order_line_item",
+		OrderStatusHistoryTableName: "shop_order_status_history",
+		ProductTableName:            "shop_product",
+		ProductCategoryTableName:    "shop_product_category",
+		ProductPriceTableName:       "shop_product_price",
+		TaxRateTableName:            "shop_tax_rate",
+		ShippingMethodTableName:     "shop_shipping_method",
+		ShipmentTableName:           "shop_shipment",
+		ShipmentLineTableName:       "shop_shipment_line",
+		RefundTableName:             "shop_refund",
+		PaymentTableName:            "shop_payment",
+		CustomerTableName:           "shop_customer",
+		AddressTableName:            "shop_address",
+		CartTableName:               "shop_cart",
+		CartItemTableName:           "shop_cart_item",
+		StockReservationTableName:   "shop_stock_reservation",
+		ProductOptionTableName:      "shop_product_option",
+		ProductOptionValueTableName: "shop_product_option_value",
+		ProductVariantTableName:     "shop_product_variant",
+		SlugRedirectTableName:       "shop_slug_redirect",
+		ProductAttributeTableName:   "shop_product_attribute",
+		AutomigrateEnabled:          true,
+	}
+}
+
+func TestNewStoreTableNameDefaults(t *testing.T) {
+	db, err := initDB(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Only the tables required from the start are named
 	store, err := NewStore(NewStoreOptions{
 		DB:                     db,
 		CategoryTableName:      "shop_category",
@@ -47,18 +101,140 @@ func initStore(filepath string) (StoreInterface, error) {
 		OrderTableName:         "shop_order",
 		OrderLineItemTableName: "shop_order_line_item",
 		ProductTableName:       "shop_product",
+		ShipmentTableName:      "shop_delivery",
 		AutomigrateEnabled:     true,
 	})
 
 	if err != nil {
-		return nil, err
+		t.Fatal("unexpected error:", err)
 	}
 
-	if store == nil {
-		return nil, errors.New("unexpected nil store")
+	expected := map[string]string{
+		"shop_discount_redemption":  store.DiscountRedemptionTableName(),
+		"shop_order_status_history": store.OrderStatusHistoryTableName(),
+		"shop_product_category":     store.ProductCategoryTableName(),
+		"shop_tax_rate":             store.TaxRateTableName(),
+		"shop_delivery":             store.ShipmentTableName(),
+		"shop_delivery_line":        store.ShipmentLineTableName(),
+		"shop_cart_item":            store.CartItemTableName(),
+		"shop_product_option_value": store.ProductOptionValueTableName(),
+		"shop_product_attribute":    store.ProductAttributeTableName(),
 	}
 
-	return store, nil
+	for name, tableName := range expected {
+		if tableName != name {
+			t.Fatal("Table name MUST be", name, "found:", tableName)
+		}
+	}
+
+	if err := store.ProductCreate(context.Background(), NewProduct().SetTitle("Ruler")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+}
+
+func TestStoreAutoMigrateBaselineSchema(t *testing.T) {
+	db, err := initDB(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The tables as created by the first version, with a row each
+	baseline := []string{
+		`CREATE TABLE "shop_category"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "parent_id" TEXT(40) NOT NULL, "title" TEXT(255) NOT NULL, "description" TEXT NOT NULL, "metas" TEXT NOT NULL, "memo" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`CREATE TABLE "shop_discount"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "title" TEXT(255) NOT NULL, "description" TEXT NOT NULL, "type" TEXT(20) NOT NULL, "amount" DECIMAL(10,2) NOT NULL, "code" TEXT(40) NOT NULL, "starts_at" DATETIME NOT NULL, "ends_at" DATETIME NOT NULL, "metas" TEXT NOT NULL, "memo" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`CREATE TABLE "shop_media"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "entity_id" TEXT(40) NOT NULL, "sequence" INTEGER NOT NULL, "media_type" TEXT(20) NOT NULL, "media_url" TEXT(510) NOT NULL, "title" TEXT(255) NOT NULL, "description" TEXT NOT NULL, "memo" TEXT NOT NULL, "metas" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`CREATE TABLE "shop_order"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "customer_id" TEXT(40) NOT NULL, "quantity" INTEGER(10) NOT NULL, "price" DECIMAL(10,2) NOT NULL, "metas" TEXT NOT NULL, "memo" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`CREATE TABLE "shop_order_line_item"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "order_id" TEXT(40) NOT NULL, "product_id" TEXT(40) NOT NULL, "title" TEXT(255) NOT NULL, "quantity" INTEGER(10) NOT NULL, "price" DECIMAL(10,2) NOT NULL, "metas" TEXT NOT NULL, "memo" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`CREATE TABLE "shop_product"("id" TEXT(40) PRIMARY KEY NOT NULL, "status" TEXT(20) NOT NULL, "title" TEXT(255) NOT NULL, "description" TEXT NOT NULL, "short_description" TEXT NOT NULL, "quantity" INTEGER(10) NOT NULL, "price" DECIMAL(10,2) NOT NULL, "metas" TEXT NOT NULL, "memo" TEXT NOT NULL, "created_at" DATETIME NOT NULL, "updated_at" DATETIME NOT NULL, "soft_deleted_at" DATETIME NOT NULL);`,
+		`INSERT INTO "shop_category" VALUES ('C1', 'active', '', 'Office', '', '{}', '', '2020-01-01 00:00:00', '2020-01-01 00:00:00', '9999-12-31 23:59:59');`,
+		`INSERT INTO "shop_discount" VALUES ('D1', 'active', 'Spring', '', 'percent', 10, 'SPRING', '2020-01-01 00:00:00', '9999-12-31 23:59:59', '{}', '', '2020-01-01 00:00:00', '2020-01-01 00:00:00', '9999-12-31 23:59:59');`,
+		`INSERT INTO "shop_order" VALUES ('O1', 'pending', 'CUSTOMER1', 1, 19.99, '{}', '', '2020-01-01 00:00:00', '2020-01-01 00:00:00', '9999-12-31 23:59:59');`,
+		`INSERT INTO "shop_product" VALUES ('P1', 'active', 'Old Ruler', '', '', 5, 19.99, '{}', '', '2020-01-01 00:00:00', '2020-01-01 00:00:00', '9999-12-31 23:59:59');`,
+		`INSERT INTO "shop_product" VALUES ('P2', 'active', 'Old Ruler', '', '', 5, 9.99, '{}', '', '2020-01-01 00:00:00', '2020-01-01 00:00:00', '9999-12-31 23:59:59');`,
+	}
+
+	for _, sqlStr := range baseline {
+		if _, err := db.Exec(sqlStr); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	store, err := NewStore(initStoreOptions(db))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Migrating again changes nothing
+	if err := store.AutoMigrate(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product, err := store.ProductFindBySlug(ctx, "old-ruler-2")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if product == nil || product.ID() != "P2" {
+		t.Fatal("Product stored before slugs MUST be found by its slug, found:", product)
+	}
+
+	if product.Currency() != MONEY_CURRENCY_DEFAULT || product.SKU() != "" || product.WeightFloat() != 0 {
+		t.Fatal("Product stored before MUST have the default currency, no SKU and no weight, found:", product.Currency(), product.SKU(), product.Weight())
+	}
+
+	category, err := store.CategoryFindBySlug(ctx, "office")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if category == nil || category.ID() != "C1" {
+		t.Fatal("Category stored before slugs MUST be found by its slug, found:", category)
+	}
+
+	discount, err := store.DiscountFindByID(ctx, "D1")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if discount == nil || discount.Duration() != DISCOUNT_DURATION_FOREVER || discount.MaxUses() != 0 {
+		t.Fatal("Discount stored before MUST last forever with unlimited uses, found:", discount)
+	}
+
+	order, err := store.OrderFindByID(ctx, "O1")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if order == nil || orderTotal(order).String() != "19.99" || order.BillingAddress() != nil {
+		t.Fatal("Order stored before MUST be to pay its price, without addresses, found:", order)
+	}
+
+	// Rows of the migrated tables have the columns added
+	lineItem := NewOrderLineItem().
+		SetOrderID(order.ID()).
+		SetProductID(product.ID()).
+		SetQuantityInt(1).
+		SetPriceMoney(product.PriceMoney())
+
+	if err := store.OrderLineItemCreate(ctx, lineItem); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductCreate(ctx, NewProduct().SetTitle("New Ruler").SetSKU("RUL-30")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductCreate(ctx, NewProduct().SetTitle("Copy").SetSKU("RUL-30")); err == nil {
+		t.Fatal("Product with a SKU taken MUST fail on the migrated table")
+	}
 }
 
 func TestStoreCategoryCreate(t *testing.T) {
@@ -4,31 +4,86 @@ const CATEGORY_STATUS_ACTIVE = "active"
 const CATEGORY_STATUS_DRAFT = "draft"
 const CATEGORY_STATUS_INACTIVE = "inactive"
 
+const COLUMN_ACTOR = "actor"
+const COLUMN_ADDRESS_LINE_1 = "address_line_1"
+const COLUMN_ADDRESS_LINE_2 = "address_line_2"
 const COLUMN_AMOUNT = "amount"
+const COLUMN_ATTRIBUTES = "attributes"
+const COLUMN_BARCODE = "barcode"
+const COLUMN_BILLING_ADDRESS = "billing_address"
+const COLUMN_CARRIER = "carrier"
+const COLUMN_CART_ID = "cart_id"
+const COLUMN_CATEGORY_ID = "category_id"
+const COLUMN_CITY = "city"
 const COLUMN_CODE = "code"
+const COLUMN_COMPANY = "company"
+const COLUMN_COUNTRY = "country"
 const COLUMN_CREATED_AT = "created_at"
+const COLUMN_CURRENCY = "currency"
 const COLUMN_CUSTOMER_ID = "customer_id"
 const COLUMN_DESCRIPTION = "description"
+const COLUMN_DISCOUNT_ID = "discount_id"
+const COLUMN_DISCOUNT_TOTAL = "discount_total"
+const COLUMN_DURATION = "duration"
+const COLUMN_DURATION_IN_MONTHS = "duration_in_months"
+const COLUMN_EMAIL = "email"
 const COLUMN_ENDS_AT = "ends_at"
 const COLUMN_ENTITY_ID = "entity_id"
+const COLUMN_ENTITY_TYPE = "entity_type"
+const COLUMN_EXPIRES_AT = "expires_at"
+const COLUMN_FIRST_NAME = "first_name"
+const COLUMN_FROM_STATUS = "from_status"
+const COLUMN_FREE_SHIPPING_THRESHOLD = "free_shipping_threshold"
+const COLUMN_GRAND_TOTAL = "grand_total"
 const COLUMN_ID = "id"
+const COLUMN_INCLUSIVE = "inclusive"
+const COLUMN_LAST_NAME = "last_name"
+const COLUMN_MAX_USES = "max_uses"
+const COLUMN_MAX_USES_PER_CUSTOMER = "max_uses_per_customer"
 const COLUMN_MEDIA_TYPE = "media_type"
 const COLUMN_MEDIA_URL = "media_url"
 const COLUMN_MEMO = "memo"
 const COLUMN_METAS = "metas"
+const COLUMN_NAME = "name"
+const COLUMN_NOTE = "note"
+const COLUMN_OPTION_ID = "option_id"
 const COLUMN_ORDER_ID = "order_id"
+const COLUMN_ORDER_LINE_ITEM_ID = "order_line_item_id"
 const COLUMN_PARENT_ID = "parent_id"
+const COLUMN_PHONE = "phone"
+const COLUMN_POSTCODE = "postcode"
 const COLUMN_PRICE = "price"
 const COLUMN_PRODUCT_ID = "product_id"
+const COLUMN_PROVIDER = "provider"
+const COLUMN_PROVIDER_REFERENCE = "provider_reference"
 const COLUMN_QUANTITY = "quantity"
+const COLUMN_RATE = "rate"
+const COLUMN_RATE_BANDS = "rate_bands"
+const COLUMN_REASON = "reason"
+const COLUMN_REGION = "region"
 const COLUMN_SEQUENCE = "sequence"
+const COLUMN_SESSION_ID = "session_id"
+const COLUMN_SHIPMENT_ID = "shipment_id"
+const COLUMN_SHIPPED_AT = "shipped_at"
+const COLUMN_SHIPPING_ADDRESS = "shipping_address"
+const COLUMN_SHIPPING_TOTAL = "shipping_total"
 const COLUMN_SOFT_DELETED_AT = "soft_deleted_at"
 const COLUMN_SHORT_DESCRIPTION = "short_description"
+const COLUMN_SKU = "sku"
+const COLUMN_SLUG = "slug"
 const COLUMN_STARTS_AT = "starts_at"
 const COLUMN_STATUS = "status"
+const COLUMN_SUBTOTAL = "subtotal"
 const COLUMN_TYPE = "type"
 const COLUMN_TITLE = "title"
+const COLUMN_TAX_TOTAL = "tax_total"
+const COLUMN_TO_STATUS = "to_status"
+const COLUMN_TRACKING_NUMBER = "tracking_number"
 const COLUMN_UPDATED_AT = "updated_at"
+const COLUMN_VALUE = "value"
+const COLUMN_VALUE_NUMERIC = "value_numeric"
+const COLUMN_VARIANT_ID = "variant_id"
+const COLUMN_WEIGHT = "weight"
 
 const MEDIA_STATUS_DRAFT = "draft"
 const MEDIA_STATUS_ACTIVE = "active"
@@ -38,6 +93,17 @@ const MEDIA_TYPE_IMAGE_JPG = "image/jpeg"
 const MEDIA_TYPE_IMAGE_PNG = "image/png"
 const MEDIA_TYPE_VIDEO_MP4 = "video/mp4"
 
+// Order metas holding the discount applied to the order (see Store.DiscountApply)
+const ORDER_META_DISCOUNT_AMOUNT = "discount_amount"
+const ORDER_META_DISCOUNT_CODE = "discount_code"
+const ORDER_META_DISCOUNT_ID = "discount_id"
+
+// Order meta holding the shipping method chosen for the order (see Store.OrderRecalculate)
+const ORDER_META_SHIPPING_METHOD_ID = "shipping_method_id"
+
+// Order meta holding the region of the customer the order is taxed in (see Store.OrderRecalculate)
+const ORDER_META_TAX_REGION = "tax_region"
+
 // Customer has completed the checkout process, but payment has yet to be confirmed.
 const ORDER_STATUS_AWAITING_PAYMENT = "awaiting_payment"
 
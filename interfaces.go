@@ -4,10 +4,192 @@ import (
 	"context"
 	"database/sql"
 	"log/slog"
+	"time"
 
 	"github.com/dromara/carbon/v2"
 )
 
+type AddressInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsBilling() bool
+	IsShipping() bool
+
+	// Setters and Getters
+
+	AddressLine1() string
+	SetAddressLine1(addressLine1 string) AddressInterface
+
+	AddressLine2() string
+	SetAddressLine2(addressLine2 string) AddressInterface
+
+	City() string
+	SetCity(city string) AddressInterface
+
+	Company() string
+	SetCompany(company string) AddressInterface
+
+	Country() string
+	SetCountry(country string) AddressInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) AddressInterface
+
+	CustomerID() string
+	SetCustomerID(customerID string) AddressInterface
+
+	FirstName() string
+	SetFirstName(firstName string) AddressInterface
+
+	ID() string
+	SetID(id string) AddressInterface
+
+	LastName() string
+	SetLastName(lastName string) AddressInterface
+
+	Memo() string
+	SetMemo(memo string) AddressInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Phone() string
+	SetPhone(phone string) AddressInterface
+
+	Postcode() string
+	SetPostcode(postcode string) AddressInterface
+
+	Region() string
+	SetRegion(region string) AddressInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) AddressInterface
+
+	Type() string
+	SetType(type_ string) AddressInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) AddressInterface
+}
+
+type CartInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	IsConverted() bool
+	IsExpired() bool
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) CartInterface
+
+	Currency() string
+	SetCurrency(currency string) CartInterface
+
+	CustomerID() string
+	SetCustomerID(customerID string) CartInterface
+
+	ExpiresAt() string
+	ExpiresAtCarbon() *carbon.Carbon
+	SetExpiresAt(expiresAt string) CartInterface
+
+	ID() string
+	SetID(id string) CartInterface
+
+	Memo() string
+	SetMemo(memo string) CartInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	OrderID() string
+	SetOrderID(orderID string) CartInterface
+
+	SessionID() string
+	SetSessionID(sessionID string) CartInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) CartInterface
+
+	Status() string
+	SetStatus(status string) CartInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) CartInterface
+}
+
+type CartItemInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Setters and Getters
+
+	CartID() string
+	SetCartID(cartID string) CartItemInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) CartItemInterface
+
+	Currency() string
+	SetCurrency(currency string) CartItemInterface
+
+	ID() string
+	SetID(id string) CartItemInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Price() string
+	SetPrice(price string) CartItemInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) CartItemInterface
+
+	ProductID() string
+	SetProductID(productID string) CartItemInterface
+
+	Quantity() string
+	SetQuantity(quantity string) CartItemInterface
+	QuantityInt() int64
+	SetQuantityInt(quantity int64) CartItemInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) CartItemInterface
+
+	Title() string
+	SetTitle(title string) CartItemInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) CartItemInterface
+}
+
 type CategoryInterface interface {
 	Data() map[string]string
 	DataChanged() map[string]string
@@ -43,6 +225,9 @@ type CategoryInterface interface {
 	Title() string
 	SetTitle(title string) CategoryInterface
 
+	Slug() string
+	SetSlug(slug string) CategoryInterface
+
 	SoftDeletedAt() string
 	SoftDeletedAtCarbon() *carbon.Carbon
 	SetSoftDeletedAt(deletedAt string) CategoryInterface
@@ -52,15 +237,73 @@ type CategoryInterface interface {
 	SetUpdatedAt(updatedAt string) CategoryInterface
 }
 
+type CustomerInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	Name() string
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) CustomerInterface
+
+	Email() string
+	SetEmail(email string) CustomerInterface
+
+	FirstName() string
+	SetFirstName(firstName string) CustomerInterface
+
+	ID() string
+	SetID(id string) CustomerInterface
+
+	LastName() string
+	SetLastName(lastName string) CustomerInterface
+
+	Memo() string
+	SetMemo(memo string) CustomerInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Phone() string
+	SetPhone(phone string) CustomerInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) CustomerInterface
+
+	Status() string
+	SetStatus(status string) CustomerInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) CustomerInterface
+}
+
 type DiscountInterface interface {
 	Data() map[string]string
 	DataChanged() map[string]string
 	MarkAsNotDirty()
 
+	// Methods
+
+	AppliesToBillingCycle(cycle int) bool
+
 	// Setters and Getters
 
 	Amount() float64
 	SetAmount(amount float64) DiscountInterface
+	AmountMoney() Money
+	SetAmountMoney(amount Money) DiscountInterface
 
 	Code() string
 	SetCode(code string) DiscountInterface
@@ -69,9 +312,18 @@ type DiscountInterface interface {
 	CreatedAtCarbon() *carbon.Carbon
 	SetCreatedAt(createdAt string) DiscountInterface
 
+	Currency() string
+	SetCurrency(currency string) DiscountInterface
+
 	Description() string
 	SetDescription(description string) DiscountInterface
 
+	Duration() string
+	SetDuration(duration string) DiscountInterface
+
+	DurationInMonths() int
+	SetDurationInMonths(durationInMonths int) DiscountInterface
+
 	EndsAt() string
 	EndsAtCarbon() *carbon.Carbon
 	SetEndsAt(endsAt string) DiscountInterface
@@ -79,6 +331,12 @@ type DiscountInterface interface {
 	ID() string
 	SetID(id string) DiscountInterface
 
+	MaxUses() int64
+	SetMaxUses(maxUses int64) DiscountInterface
+
+	MaxUsesPerCustomer() int64
+	SetMaxUsesPerCustomer(maxUsesPerCustomer int64) DiscountInterface
+
 	Memo() string
 	SetMemo(memo string) DiscountInterface
 
@@ -113,6 +371,28 @@ type DiscountInterface interface {
 	SetUpdatedAt(updatedAt string) DiscountInterface
 }
 
+type DiscountRedemptionInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) DiscountRedemptionInterface
+
+	CustomerID() string
+	SetCustomerID(customerID string) DiscountRedemptionInterface
+
+	DiscountID() string
+	SetDiscountID(discountID string) DiscountRedemptionInterface
+
+	ID() string
+	SetID(id string) DiscountRedemptionInterface
+
+	OrderID() string
+	SetOrderID(orderID string) DiscountRedemptionInterface
+}
+
 type MediaInterface interface {
 	Data() map[string]string
 	DataChanged() map[string]string
@@ -187,13 +467,29 @@ type OrderInterface interface {
 	IsShipped() bool
 
 	// Setters and Getters
+	BillingAddress() AddressInterface
+	SetBillingAddress(address AddressInterface) OrderInterface
+
 	CreatedAt() string
 	CreatedAtCarbon() *carbon.Carbon
 	SetCreatedAt(createdAt string) OrderInterface
 
+	Currency() string
+	SetCurrency(currency string) OrderInterface
+
 	CustomerID() string
 	SetCustomerID(customerID string) OrderInterface
 
+	DiscountTotal() string
+	SetDiscountTotal(discountTotal string) OrderInterface
+	DiscountTotalMoney() Money
+	SetDiscountTotalMoney(discountTotal Money) OrderInterface
+
+	GrandTotal() string
+	SetGrandTotal(grandTotal string) OrderInterface
+	GrandTotalMoney() Money
+	SetGrandTotalMoney(grandTotal Money) OrderInterface
+
 	ID() string
 	SetID(id string) OrderInterface
 
@@ -210,12 +506,22 @@ type OrderInterface interface {
 	SetPrice(price string) OrderInterface
 	PriceFloat() float64
 	SetPriceFloat(price float64) OrderInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) OrderInterface
 
 	Quantity() string
 	SetQuantity(quantity string) OrderInterface
 	QuantityInt() int64
 	SetQuantityInt(quantity int64) OrderInterface
 
+	ShippingAddress() AddressInterface
+	SetShippingAddress(address AddressInterface) OrderInterface
+
+	ShippingTotal() string
+	SetShippingTotal(shippingTotal string) OrderInterface
+	ShippingTotalMoney() Money
+	SetShippingTotalMoney(shippingTotal Money) OrderInterface
+
 	SoftDeletedAt() string
 	SoftDeletedAtCarbon() *carbon.Carbon
 	SetSoftDeletedAt(deletedAt string) OrderInterface
@@ -223,6 +529,16 @@ type OrderInterface interface {
 	Status() string
 	SetStatus(status string) OrderInterface
 
+	Subtotal() string
+	SetSubtotal(subtotal string) OrderInterface
+	SubtotalMoney() Money
+	SetSubtotalMoney(subtotal Money) OrderInterface
+
+	TaxTotal() string
+	SetTaxTotal(taxTotal string) OrderInterface
+	TaxTotalMoney() Money
+	SetTaxTotalMoney(taxTotal Money) OrderInterface
+
 	UpdatedAt() string
 	UpdatedAtCarbon() *carbon.Carbon
 	SetUpdatedAt(updatedAt string) OrderInterface
@@ -237,6 +553,9 @@ type OrderLineItemInterface interface {
 	CreatedAtCarbon() *carbon.Carbon
 	SetCreatedAt(createdAt string) OrderLineItemInterface
 
+	Currency() string
+	SetCurrency(currency string) OrderLineItemInterface
+
 	ID() string
 	SetID(id string) OrderLineItemInterface
 
@@ -257,6 +576,8 @@ type OrderLineItemInterface interface {
 
 	PriceFloat() float64
 	SetPriceFloat(price float64) OrderLineItemInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) OrderLineItemInterface
 
 	ProductID() string
 	SetProductID(productID string) OrderLineItemInterface
@@ -280,70 +601,591 @@ type OrderLineItemInterface interface {
 	UpdatedAt() string
 	UpdatedAtCarbon() *carbon.Carbon
 	SetUpdatedAt(updatedAt string) OrderLineItemInterface
+
+	VariantID() string
+	SetVariantID(variantID string) OrderLineItemInterface
 }
 
-type ProductInterface interface {
+type OrderStatusHistoryInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	Actor() string
+	SetActor(actor string) OrderStatusHistoryInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) OrderStatusHistoryInterface
+
+	FromStatus() string
+	SetFromStatus(fromStatus string) OrderStatusHistoryInterface
+
+	ID() string
+	SetID(id string) OrderStatusHistoryInterface
+
+	Note() string
+	SetNote(note string) OrderStatusHistoryInterface
+
+	OrderID() string
+	SetOrderID(orderID string) OrderStatusHistoryInterface
+
+	ToStatus() string
+	SetToStatus(toStatus string) OrderStatusHistoryInterface
+}
+
+type ProductAttributeInterface interface {
 	Data() map[string]string
 	DataChanged() map[string]string
 	MarkAsNotDirty()
 
 	// Methods
 
-	IsActive() bool
-	IsDisabled() bool
-	IsDraft() bool
-	IsSoftDeleted() bool
-	IsFree() bool
-	Slug() string
+	HasValueNumeric() bool
 
 	// Setters and Getters
 
 	CreatedAt() string
 	CreatedAtCarbon() *carbon.Carbon
-	SetCreatedAt(createdAt string) ProductInterface
-
-	Description() string
-	SetDescription(description string) ProductInterface
+	SetCreatedAt(createdAt string) ProductAttributeInterface
 
 	ID() string
-	SetID(id string) ProductInterface
+	SetID(id string) ProductAttributeInterface
 
-	Memo() string
-	SetMemo(memo string) ProductInterface
+	Name() string
+	SetName(name string) ProductAttributeInterface
 
-	Meta(name string) string
-	SetMeta(name string, value string) error
+	ProductID() string
+	SetProductID(productID string) ProductAttributeInterface
 
-	Metas() (map[string]string, error)
-	SetMetas(metas map[string]string) error
-	UpsertMetas(metas map[string]string) error
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ProductAttributeInterface
 
-	Price() string
-	SetPrice(price string) ProductInterface
-	PriceFloat() float64
-	SetPriceFloat(price float64) ProductInterface
+	Value() string
+	SetValue(value string) ProductAttributeInterface
 
-	Quantity() string
-	SetQuantity(quantity string) ProductInterface
-	QuantityInt() int64
-	SetQuantityInt(quantity int64) ProductInterface
+	ValueNumeric() string
+	SetValueNumeric(valueNumeric string) ProductAttributeInterface
+	ValueNumericFloat() float64
+	SetValueNumericFloat(valueNumeric float64) ProductAttributeInterface
+}
 
-	SoftDeletedAt() string
-	SoftDeletedAtCarbon() *carbon.Carbon
-	SetSoftDeletedAt(deletedAt string) ProductInterface
+type ProductOptionInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
 
-	ShortDescription() string
-	SetShortDescription(shortDescription string) ProductInterface
+	// Setters and Getters
 
-	Status() string
-	SetStatus(status string) ProductInterface
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ProductOptionInterface
+
+	ID() string
+	SetID(id string) ProductOptionInterface
+
+	ProductID() string
+	SetProductID(productID string) ProductOptionInterface
+
+	Sequence() int
+	SetSequence(sequence int) ProductOptionInterface
 
 	Title() string
-	SetTitle(title string) ProductInterface
+	SetTitle(title string) ProductOptionInterface
 
 	UpdatedAt() string
 	UpdatedAtCarbon() *carbon.Carbon
-	SetUpdatedAt(updatedAt string) ProductInterface
+	SetUpdatedAt(updatedAt string) ProductOptionInterface
+}
+
+type ProductOptionValueInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ProductOptionValueInterface
+
+	ID() string
+	SetID(id string) ProductOptionValueInterface
+
+	OptionID() string
+	SetOptionID(optionID string) ProductOptionValueInterface
+
+	Sequence() int
+	SetSequence(sequence int) ProductOptionValueInterface
+
+	Title() string
+	SetTitle(title string) ProductOptionValueInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ProductOptionValueInterface
+}
+
+type ProductPriceInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ProductPriceInterface
+
+	Currency() string
+	SetCurrency(currency string) ProductPriceInterface
+
+	ID() string
+	SetID(id string) ProductPriceInterface
+
+	Price() string
+	SetPrice(price string) ProductPriceInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) ProductPriceInterface
+
+	ProductID() string
+	SetProductID(productID string) ProductPriceInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ProductPriceInterface
+}
+
+type PaymentInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsCaptured() bool
+
+	// Setters and Getters
+
+	Amount() string
+	SetAmount(amount string) PaymentInterface
+	AmountMoney() Money
+	SetAmountMoney(amount Money) PaymentInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) PaymentInterface
+
+	Currency() string
+	SetCurrency(currency string) PaymentInterface
+
+	ID() string
+	SetID(id string) PaymentInterface
+
+	Memo() string
+	SetMemo(memo string) PaymentInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	OrderID() string
+	SetOrderID(orderID string) PaymentInterface
+
+	Provider() string
+	SetProvider(provider string) PaymentInterface
+
+	ProviderReference() string
+	SetProviderReference(providerReference string) PaymentInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(deletedAt string) PaymentInterface
+
+	Status() string
+	SetStatus(status string) PaymentInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) PaymentInterface
+}
+
+type ProductInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	IsDisabled() bool
+	IsDraft() bool
+	IsSoftDeleted() bool
+	IsFree() bool
+
+	// Setters and Getters
+
+	Barcode() string
+	SetBarcode(barcode string) ProductInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ProductInterface
+
+	Currency() string
+	SetCurrency(currency string) ProductInterface
+
+	Description() string
+	SetDescription(description string) ProductInterface
+
+	ID() string
+	SetID(id string) ProductInterface
+
+	Memo() string
+	SetMemo(memo string) ProductInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Price() string
+	SetPrice(price string) ProductInterface
+	PriceFloat() float64
+	SetPriceFloat(price float64) ProductInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) ProductInterface
+
+	Quantity() string
+	SetQuantity(quantity string) ProductInterface
+	QuantityInt() int64
+	SetQuantityInt(quantity int64) ProductInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(deletedAt string) ProductInterface
+
+	ShortDescription() string
+	SetShortDescription(shortDescription string) ProductInterface
+
+	SKU() string
+	SetSKU(sku string) ProductInterface
+
+	Slug() string
+	SetSlug(slug string) ProductInterface
+
+	Status() string
+	SetStatus(status string) ProductInterface
+
+	Title() string
+	SetTitle(title string) ProductInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ProductInterface
+
+	Weight() string
+	SetWeight(weight string) ProductInterface
+	WeightFloat() float64
+	SetWeightFloat(weight float64) ProductInterface
+}
+
+type ProductVariantInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	HasPrice() bool
+
+	// Setters and Getters
+
+	Attributes() (map[string]string, error)
+	SetAttributes(attributes map[string]string) error
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ProductVariantInterface
+
+	Currency() string
+	SetCurrency(currency string) ProductVariantInterface
+
+	ID() string
+	SetID(id string) ProductVariantInterface
+
+	Memo() string
+	SetMemo(memo string) ProductVariantInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Price() string
+	SetPrice(price string) ProductVariantInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) ProductVariantInterface
+
+	ProductID() string
+	SetProductID(productID string) ProductVariantInterface
+
+	Quantity() string
+	SetQuantity(quantity string) ProductVariantInterface
+	QuantityInt() int64
+	SetQuantityInt(quantity int64) ProductVariantInterface
+
+	SKU() string
+	SetSKU(sku string) ProductVariantInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) ProductVariantInterface
+
+	Status() string
+	SetStatus(status string) ProductVariantInterface
+
+	Title() string
+	SetTitle(title string) ProductVariantInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ProductVariantInterface
+}
+
+type RefundInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	Amount() string
+	SetAmount(amount string) RefundInterface
+	AmountMoney() Money
+	SetAmountMoney(amount Money) RefundInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) RefundInterface
+
+	Currency() string
+	SetCurrency(currency string) RefundInterface
+
+	ID() string
+	SetID(id string) RefundInterface
+
+	Memo() string
+	SetMemo(memo string) RefundInterface
+
+	OrderID() string
+	SetOrderID(orderID string) RefundInterface
+
+	OrderLineItemID() string
+	SetOrderLineItemID(orderLineItemID string) RefundInterface
+
+	Reason() string
+	SetReason(reason string) RefundInterface
+}
+
+type ShipmentInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Setters and Getters
+
+	Carrier() string
+	SetCarrier(carrier string) ShipmentInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ShipmentInterface
+
+	ID() string
+	SetID(id string) ShipmentInterface
+
+	Memo() string
+	SetMemo(memo string) ShipmentInterface
+
+	OrderID() string
+	SetOrderID(orderID string) ShipmentInterface
+
+	ShippedAt() string
+	ShippedAtCarbon() *carbon.Carbon
+	SetShippedAt(shippedAt string) ShipmentInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(softDeletedAt string) ShipmentInterface
+
+	TrackingNumber() string
+	SetTrackingNumber(trackingNumber string) ShipmentInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ShipmentInterface
+}
+
+type ShipmentLineInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ShipmentLineInterface
+
+	ID() string
+	SetID(id string) ShipmentLineInterface
+
+	OrderLineItemID() string
+	SetOrderLineItemID(orderLineItemID string) ShipmentLineInterface
+
+	Quantity() string
+	SetQuantity(quantity string) ShipmentLineInterface
+	QuantityInt() int64
+	SetQuantityInt(quantity int64) ShipmentLineInterface
+
+	ShipmentID() string
+	SetShipmentID(shipmentID string) ShipmentLineInterface
+}
+
+type ShippingMethodInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	Quote(subtotal Money, weightGrams int64) (Money, bool)
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) ShippingMethodInterface
+
+	Currency() string
+	SetCurrency(currency string) ShippingMethodInterface
+
+	Description() string
+	SetDescription(description string) ShippingMethodInterface
+
+	FreeShippingThreshold() string
+	SetFreeShippingThreshold(threshold string) ShippingMethodInterface
+	FreeShippingThresholdMoney() Money
+	SetFreeShippingThresholdMoney(threshold Money) ShippingMethodInterface
+
+	ID() string
+	SetID(id string) ShippingMethodInterface
+
+	Memo() string
+	SetMemo(memo string) ShippingMethodInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Price() string
+	SetPrice(price string) ShippingMethodInterface
+	PriceMoney() Money
+	SetPriceMoney(price Money) ShippingMethodInterface
+
+	RateBands() ([]ShippingRateBand, error)
+	SetRateBands(bands []ShippingRateBand) error
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(deletedAt string) ShippingMethodInterface
+
+	Status() string
+	SetStatus(status string) ShippingMethodInterface
+
+	Title() string
+	SetTitle(title string) ShippingMethodInterface
+
+	Type() string
+	SetType(type_ string) ShippingMethodInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) ShippingMethodInterface
+}
+
+type SlugRedirectInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) SlugRedirectInterface
+
+	EntityID() string
+	SetEntityID(entityID string) SlugRedirectInterface
+
+	EntityType() string
+	SetEntityType(entityType string) SlugRedirectInterface
+
+	ID() string
+	SetID(id string) SlugRedirectInterface
+
+	Slug() string
+	SetSlug(slug string) SlugRedirectInterface
+}
+
+type StockReservationInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	IsActive() bool
+	IsExpired() bool
+
+	// Setters and Getters
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) StockReservationInterface
+
+	ExpiresAt() string
+	ExpiresAtCarbon() *carbon.Carbon
+	SetExpiresAt(expiresAt string) StockReservationInterface
+
+	ID() string
+	SetID(id string) StockReservationInterface
+
+	OrderID() string
+	SetOrderID(orderID string) StockReservationInterface
+
+	ProductID() string
+	SetProductID(productID string) StockReservationInterface
+
+	Quantity() string
+	SetQuantity(quantity string) StockReservationInterface
+	QuantityInt() int64
+	SetQuantityInt(quantity int64) StockReservationInterface
+
+	Status() string
+	SetStatus(status string) StockReservationInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) StockReservationInterface
+
+	VariantID() string
+	SetVariantID(variantID string) StockReservationInterface
 }
 
 type StoreInterface interface {
@@ -353,21 +1195,46 @@ type StoreInterface interface {
 
 	CategoryTableName() string
 	DiscountTableName() string
+	DiscountRedemptionTableName() string
 	MediaTableName() string
 	OrderTableName() string
 	OrderLineItemTableName() string
+	OrderStatusHistoryTableName() string
 	ProductTableName() string
+	ProductCategoryTableName() string
+	ProductPriceTableName() string
+	TaxRateTableName() string
+	ShippingMethodTableName() string
+	ShipmentTableName() string
+	ShipmentLineTableName() string
+	RefundTableName() string
+	PaymentTableName() string
+	CustomerTableName() string
+	AddressTableName() string
+	CartTableName() string
+	CartItemTableName() string
+	StockReservationTableName() string
+	ProductOptionTableName() string
+	ProductOptionValueTableName() string
+	ProductVariantTableName() string
+	SlugRedirectTableName() string
+	ProductAttributeTableName() string
 
 	CategoryCount(ctx context.Context, options CategoryQueryInterface) (int64, error)
 	CategoryCreate(context context.Context, category CategoryInterface) error
 	CategoryDelete(context context.Context, category CategoryInterface) error
 	CategoryDeleteByID(context context.Context, categoryID string) error
 	CategoryFindByID(context context.Context, categoryID string) (CategoryInterface, error)
+	CategoryFindBySlug(ctx context.Context, slug string) (CategoryInterface, error)
 	CategoryList(context context.Context, options CategoryQueryInterface) ([]CategoryInterface, error)
 	CategorySoftDelete(context context.Context, category CategoryInterface) error
 	CategorySoftDeleteByID(context context.Context, categoryID string) error
 	CategoryUpdate(contxt context.Context, category CategoryInterface) error
 
+	CategoryAncestors(ctx context.Context, categoryID string) ([]CategoryInterface, error)
+	CategoryDescendants(ctx context.Context, categoryID string) ([]CategoryInterface, error)
+	CategoryTree(ctx context.Context, rootID string) ([]CategoryTreeNode, error)
+
 	DiscountCount(ctx context.Context, options DiscountQueryInterface) (int64, error)
 	DiscountCreate(ctx context.Context, discount DiscountInterface) error
 	DiscountDelete(ctx context.Context, discount DiscountInterface) error
@@ -379,6 +1246,12 @@ type StoreInterface interface {
 	DiscountSoftDeleteByID(ctx context.Context, discountID string) error
 	DiscountUpdate(ctx context.Context, discount DiscountInterface) error
 
+	DiscountApply(ctx context.Context, code string, order OrderInterface, lineItems []OrderLineItemInterface) (float64, error)
+	DiscountRedeem(ctx context.Context, discount DiscountInterface, order OrderInterface) error
+
+	DiscountRedemptionCount(ctx context.Context, options DiscountRedemptionQueryInterface) (int64, error)
+	DiscountRedemptionList(ctx context.Context, options DiscountRedemptionQueryInterface) ([]DiscountRedemptionInterface, error)
+
 	MediaCount(ctx context.Context, options MediaQueryInterface) (int64, error)
 	MediaCreate(ctx context.Context, media MediaInterface) error
 	MediaDelete(ctx context.Context, media MediaInterface) error
@@ -399,6 +1272,16 @@ type StoreInterface interface {
 	OrderSoftDeleteByID(ctx context.Context, id string) error
 	OrderUpdate(ctx context.Context, order OrderInterface) error
 
+	OrderStatusHistoryCreate(ctx context.Context, history OrderStatusHistoryInterface) error
+	OrderStatusHistoryList(ctx context.Context, orderID string) ([]OrderStatusHistoryInterface, error)
+
+	OrderStatusTransitionAllowed(from string, to string) bool
+	OrderTransition(ctx context.Context, order OrderInterface, newStatus string) error
+
+	PlaceOrder(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) error
+
+	OrderRecalculate(ctx context.Context, orderID string) (OrderInterface, error)
+
 	OrderLineItemCount(ctx context.Context, options OrderLineItemQueryInterface) (int64, error)
 	OrderLineItemCreate(ctx context.Context, orderLineItem OrderLineItemInterface) error
 	OrderLineItemDelete(ctx context.Context, orderLineItem OrderLineItemInterface) error
@@ -413,9 +1296,213 @@ type StoreInterface interface {
 	ProductCreate(ctx context.Context, product ProductInterface) error
 	ProductDelete(ctx context.Context, product ProductInterface) error
 	ProductDeleteByID(ctx context.Context, productID string) error
+	ProductFindByBarcode(ctx context.Context, barcode string) (ProductInterface, error)
 	ProductFindByID(ctx context.Context, productID string) (ProductInterface, error)
+	ProductFindBySlug(ctx context.Context, slug string) (ProductInterface, error)
+	ProductFindBySKU(ctx context.Context, sku string) (ProductInterface, error)
 	ProductList(ctx context.Context, options ProductQueryInterface) ([]ProductInterface, error)
 	ProductSoftDelete(ctx context.Context, product ProductInterface) error
 	ProductSoftDeleteByID(ctx context.Context, productID string) error
 	ProductUpdate(ctx context.Context, product ProductInterface) error
+
+	ProductCategoryAdd(ctx context.Context, productID string, categoryID string) error
+	ProductCategoryList(ctx context.Context, productID string) ([]string, error)
+	ProductCategoryRemove(ctx context.Context, productID string, categoryID string) error
+
+	ProductPriceGet(ctx context.Context, productID string, currency string) (ProductPriceInterface, error)
+	ProductPriceList(ctx context.Context, productID string) ([]ProductPriceInterface, error)
+	ProductPriceRemove(ctx context.Context, productID string, currency string) error
+	ProductPriceSet(ctx context.Context, productID string, price Money) error
+
+	TaxRateCount(ctx context.Context, options TaxRateQueryInterface) (int64, error)
+	TaxRateCreate(ctx context.Context, taxRate TaxRateInterface) error
+	TaxRateDelete(ctx context.Context, taxRate TaxRateInterface) error
+	TaxRateDeleteByID(ctx context.Context, id string) error
+	TaxRateFindByID(ctx context.Context, id string) (TaxRateInterface, error)
+	TaxRateList(ctx context.Context, options TaxRateQueryInterface) ([]TaxRateInterface, error)
+	TaxRateSoftDelete(ctx context.Context, taxRate TaxRateInterface) error
+	TaxRateSoftDeleteByID(ctx context.Context, id string) error
+	TaxRateUpdate(ctx context.Context, taxRate TaxRateInterface) error
+
+	TaxCalculateLineItems(ctx context.Context, region string, lineItems []OrderLineItemInterface) ([]LineItemTax, error)
+
+	ShippingMethodCount(ctx context.Context, options ShippingMethodQueryInterface) (int64, error)
+	ShippingMethodCreate(ctx context.Context, shippingMethod ShippingMethodInterface) error
+	ShippingMethodDelete(ctx context.Context, shippingMethod ShippingMethodInterface) error
+	ShippingMethodDeleteByID(ctx context.Context, id string) error
+	ShippingMethodFindByID(ctx context.Context, id string) (ShippingMethodInterface, error)
+	ShippingMethodList(ctx context.Context, options ShippingMethodQueryInterface) ([]ShippingMethodInterface, error)
+	ShippingMethodSoftDelete(ctx context.Context, shippingMethod ShippingMethodInterface) error
+	ShippingMethodSoftDeleteByID(ctx context.Context, id string) error
+	ShippingMethodUpdate(ctx context.Context, shippingMethod ShippingMethodInterface) error
+
+	ShippingQuote(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) ([]ShippingMethodQuote, error)
+
+	ShipmentCount(ctx context.Context, options ShipmentQueryInterface) (int64, error)
+	ShipmentCreate(ctx context.Context, shipment ShipmentInterface, lines []ShipmentLineInterface) error
+	ShipmentDelete(ctx context.Context, shipment ShipmentInterface) error
+	ShipmentDeleteByID(ctx context.Context, id string) error
+	ShipmentFindByID(ctx context.Context, id string) (ShipmentInterface, error)
+	ShipmentList(ctx context.Context, options ShipmentQueryInterface) ([]ShipmentInterface, error)
+	ShipmentSoftDelete(ctx context.Context, shipment ShipmentInterface) error
+	ShipmentSoftDeleteByID(ctx context.Context, id string) error
+	ShipmentUpdate(ctx context.Context, shipment ShipmentInterface) error
+
+	ShipmentLineList(ctx context.Context, shipmentID string) ([]ShipmentLineInterface, error)
+
+	RefundCount(ctx context.Context, options RefundQueryInterface) (int64, error)
+	RefundCreate(ctx context.Context, refund RefundInterface) error
+	RefundFindByID(ctx context.Context, id string) (RefundInterface, error)
+	RefundList(ctx context.Context, options RefundQueryInterface) ([]RefundInterface, error)
+	RefundTotal(ctx context.Context, orderID string) (Money, error)
+
+	PaymentCount(ctx context.Context, options PaymentQueryInterface) (int64, error)
+	PaymentCreate(ctx context.Context, payment PaymentInterface) error
+	PaymentDelete(ctx context.Context, payment PaymentInterface) error
+	PaymentDeleteByID(ctx context.Context, id string) error
+	PaymentFindByID(ctx context.Context, id string) (PaymentInterface, error)
+	PaymentList(ctx context.Context, options PaymentQueryInterface) ([]PaymentInterface, error)
+	PaymentSoftDelete(ctx context.Context, payment PaymentInterface) error
+	PaymentSoftDeleteByID(ctx context.Context, id string) error
+	PaymentUpdate(ctx context.Context, payment PaymentInterface) error
+
+	CustomerCount(ctx context.Context, options CustomerQueryInterface) (int64, error)
+	CustomerCreate(ctx context.Context, customer CustomerInterface) error
+	CustomerDelete(ctx context.Context, customer CustomerInterface) error
+	CustomerDeleteByID(ctx context.Context, id string) error
+	CustomerFindByEmail(ctx context.Context, email string) (CustomerInterface, error)
+	CustomerFindByID(ctx context.Context, id string) (CustomerInterface, error)
+	CustomerList(ctx context.Context, options CustomerQueryInterface) ([]CustomerInterface, error)
+	CustomerSoftDelete(ctx context.Context, customer CustomerInterface) error
+	CustomerSoftDeleteByID(ctx context.Context, id string) error
+	CustomerUpdate(ctx context.Context, customer CustomerInterface) error
+
+	AddressCount(ctx context.Context, options AddressQueryInterface) (int64, error)
+	AddressCreate(ctx context.Context, address AddressInterface) error
+	AddressDelete(ctx context.Context, address AddressInterface) error
+	AddressDeleteByID(ctx context.Context, id string) error
+	AddressFindByID(ctx context.Context, id string) (AddressInterface, error)
+	AddressList(ctx context.Context, options AddressQueryInterface) ([]AddressInterface, error)
+	AddressSoftDelete(ctx context.Context, address AddressInterface) error
+	AddressSoftDeleteByID(ctx context.Context, id string) error
+	AddressUpdate(ctx context.Context, address AddressInterface) error
+
+	CartCount(ctx context.Context, options CartQueryInterface) (int64, error)
+	CartCreate(ctx context.Context, cart CartInterface) error
+	CartDelete(ctx context.Context, cart CartInterface) error
+	CartDeleteByID(ctx context.Context, id string) error
+	CartFindByID(ctx context.Context, id string) (CartInterface, error)
+	CartList(ctx context.Context, options CartQueryInterface) ([]CartInterface, error)
+	CartSoftDelete(ctx context.Context, cart CartInterface) error
+	CartSoftDeleteByID(ctx context.Context, id string) error
+	CartUpdate(ctx context.Context, cart CartInterface) error
+
+	CartConvertToOrder(ctx context.Context, cartID string) (OrderInterface, []OrderLineItemInterface, error)
+
+	CartItemAdd(ctx context.Context, cart CartInterface, product ProductInterface, quantity int64) (CartItemInterface, error)
+	CartItemCount(ctx context.Context, options CartItemQueryInterface) (int64, error)
+	CartItemCreate(ctx context.Context, cartItem CartItemInterface) error
+	CartItemDelete(ctx context.Context, cartItem CartItemInterface) error
+	CartItemDeleteByID(ctx context.Context, id string) error
+	CartItemFindByID(ctx context.Context, id string) (CartItemInterface, error)
+	CartItemList(ctx context.Context, options CartItemQueryInterface) ([]CartItemInterface, error)
+	CartItemSoftDelete(ctx context.Context, cartItem CartItemInterface) error
+	CartItemSoftDeleteByID(ctx context.Context, id string) error
+	CartItemUpdate(ctx context.Context, cartItem CartItemInterface) error
+
+	PurgeStale(ctx context.Context, olderThan time.Duration) (PurgeReport, error)
+
+	AvailableQuantity(ctx context.Context, productID string) (int64, error)
+	StockReserve(ctx context.Context, orderID string, productID string, quantity int64, duration time.Duration) (StockReservationInterface, error)
+	StockReservationFindByID(ctx context.Context, id string) (StockReservationInterface, error)
+	StockReservationList(ctx context.Context, options StockReservationQueryInterface) ([]StockReservationInterface, error)
+	StockReservationRelease(ctx context.Context, id string) error
+
+	ProductOptionCreate(ctx context.Context, option ProductOptionInterface) error
+	ProductOptionDelete(ctx context.Context, option ProductOptionInterface) error
+	ProductOptionFindByID(ctx context.Context, id string) (ProductOptionInterface, error)
+	ProductOptionList(ctx context.Context, productID string) ([]ProductOptionInterface, error)
+	ProductOptionUpdate(ctx context.Context, option ProductOptionInterface) error
+
+	ProductOptionValueCreate(ctx context.Context, optionValue ProductOptionValueInterface) error
+	ProductOptionValueDelete(ctx context.Context, optionValue ProductOptionValueInterface) error
+	ProductOptionValueFindByID(ctx context.Context, id string) (ProductOptionValueInterface, error)
+	ProductOptionValueList(ctx context.Context, optionID string) ([]ProductOptionValueInterface, error)
+	ProductOptionValueUpdate(ctx context.Context, optionValue ProductOptionValueInterface) error
+
+	ProductVariantCount(ctx context.Context, options ProductVariantQueryInterface) (int64, error)
+	ProductVariantCreate(ctx context.Context, productVariant ProductVariantInterface) error
+	ProductVariantDelete(ctx context.Context, productVariant ProductVariantInterface) error
+	ProductVariantDeleteByID(ctx context.Context, id string) error
+	ProductVariantFindByID(ctx context.Context, id string) (ProductVariantInterface, error)
+	ProductVariantList(ctx context.Context, options ProductVariantQueryInterface) ([]ProductVariantInterface, error)
+	ProductVariantSoftDelete(ctx context.Context, productVariant ProductVariantInterface) error
+	ProductVariantSoftDeleteByID(ctx context.Context, id string) error
+	ProductVariantUpdate(ctx context.Context, productVariant ProductVariantInterface) error
+
+	SlugRedirectList(ctx context.Context, entityType string, entityID string) ([]SlugRedirectInterface, error)
+
+	ProductAttributeCreate(ctx context.Context, attribute ProductAttributeInterface) error
+	ProductAttributeDelete(ctx context.Context, attribute ProductAttributeInterface) error
+	ProductAttributeFacets(ctx context.Context, options ProductQueryInterface, names ...string) ([]ProductAttributeFacet, error)
+	ProductAttributeFindByID(ctx context.Context, id string) (ProductAttributeInterface, error)
+	ProductAttributeList(ctx context.Context, productID string) ([]ProductAttributeInterface, error)
+	ProductAttributeUpdate(ctx context.Context, attribute ProductAttributeInterface) error
+}
+
+type TaxRateInterface interface {
+	Data() map[string]string
+	DataChanged() map[string]string
+	MarkAsNotDirty()
+
+	// Methods
+
+	Calculate(amount Money) Money
+	IsActive() bool
+
+	// Setters and Getters
+
+	CategoryID() string
+	SetCategoryID(categoryID string) TaxRateInterface
+
+	CreatedAt() string
+	CreatedAtCarbon() *carbon.Carbon
+	SetCreatedAt(createdAt string) TaxRateInterface
+
+	ID() string
+	SetID(id string) TaxRateInterface
+
+	IsInclusive() bool
+	SetInclusive(inclusive bool) TaxRateInterface
+
+	Memo() string
+	SetMemo(memo string) TaxRateInterface
+
+	Meta(name string) string
+	SetMeta(name string, value string) error
+	Metas() (map[string]string, error)
+	SetMetas(metas map[string]string) error
+	UpsertMetas(metas map[string]string) error
+
+	Rate() string
+	SetRate(rate string) TaxRateInterface
+	RateFloat() float64
+	SetRateFloat(rate float64) TaxRateInterface
+
+	Region() string
+	SetRegion(region string) TaxRateInterface
+
+	SoftDeletedAt() string
+	SoftDeletedAtCarbon() *carbon.Carbon
+	SetSoftDeletedAt(deletedAt string) TaxRateInterface
+
+	Status() string
+	SetStatus(status string) TaxRateInterface
+
+	Title() string
+	SetTitle(title string) TaxRateInterface
+
+	UpdatedAt() string
+	UpdatedAtCarbon() *carbon.Carbon
+	SetUpdatedAt(updatedAt string) TaxRateInterface
 }
@@ -0,0 +1,297 @@
+package shopstore
+
+import "errors"
+
+type AddressQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) AddressQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) AddressQueryInterface
+
+	HasCountry() bool
+	Country() string
+	SetCountry(country string) AddressQueryInterface
+
+	HasCustomerID() bool
+	CustomerID() string
+	SetCustomerID(customerID string) AddressQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) AddressQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) AddressQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) AddressQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) AddressQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) AddressQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) AddressQueryInterface
+
+	HasType() bool
+	Type() string
+	SetType(type_ string) AddressQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewAddressQuery() AddressQueryInterface {
+	return &addressQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type addressQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *addressQueryImplementation) Validate() error {
+	if c.HasCountry() && c.Country() == "" {
+		return errors.New("address query. country cannot be empty")
+	}
+
+	if c.HasCustomerID() && c.CustomerID() == "" {
+		return errors.New("address query. customer_id cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("address query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("address query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("address query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("address query. order_by cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("address query. sort_direction cannot be empty")
+	}
+
+	if c.HasType() && c.Type() == "" {
+		return errors.New("address query. type cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *addressQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *addressQueryImplementation) SetColumns(columns []string) AddressQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *addressQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *addressQueryImplementation) SetCountOnly(countOnly bool) AddressQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasCountry() bool {
+	return c.hasProperty("country")
+}
+
+func (c *addressQueryImplementation) Country() string {
+	if !c.HasCountry() {
+		return ""
+	}
+
+	return c.properties["country"].(string)
+}
+
+func (c *addressQueryImplementation) SetCountry(country string) AddressQueryInterface {
+	c.properties["country"] = country
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasCustomerID() bool {
+	return c.hasProperty("customer_id")
+}
+
+func (c *addressQueryImplementation) CustomerID() string {
+	if !c.HasCustomerID() {
+		return ""
+	}
+
+	return c.properties["customer_id"].(string)
+}
+
+func (c *addressQueryImplementation) SetCustomerID(customerID string) AddressQueryInterface {
+	c.properties["customer_id"] = customerID
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *addressQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *addressQueryImplementation) SetID(id string) AddressQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *addressQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *addressQueryImplementation) SetLimit(limit int) AddressQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *addressQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *addressQueryImplementation) SetOffset(offset int) AddressQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *addressQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *addressQueryImplementation) SetOrderBy(orderBy string) AddressQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *addressQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *addressQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) AddressQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *addressQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *addressQueryImplementation) SetSortDirection(sortDirection string) AddressQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *addressQueryImplementation) HasType() bool {
+	return c.hasProperty("type")
+}
+
+func (c *addressQueryImplementation) Type() string {
+	if !c.HasType() {
+		return ""
+	}
+
+	return c.properties["type"].(string)
+}
+
+func (c *addressQueryImplementation) SetType(type_ string) AddressQueryInterface {
+	c.properties["type"] = type_
+
+	return c
+}
+
+func (c *addressQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
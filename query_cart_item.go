@@ -0,0 +1,271 @@
+package shopstore
+
+import "errors"
+
+type CartItemQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) CartItemQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) CartItemQueryInterface
+
+	HasCartID() bool
+	CartID() string
+	SetCartID(cartID string) CartItemQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) CartItemQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) CartItemQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) CartItemQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) CartItemQueryInterface
+
+	HasProductID() bool
+	ProductID() string
+	SetProductID(productID string) CartItemQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) CartItemQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) CartItemQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewCartItemQuery() CartItemQueryInterface {
+	return &cartItemQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type cartItemQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *cartItemQueryImplementation) Validate() error {
+	if c.HasCartID() && c.CartID() == "" {
+		return errors.New("cart item query. cart_id cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("cart item query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("cart item query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("cart item query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("cart item query. order_by cannot be empty")
+	}
+
+	if c.HasProductID() && c.ProductID() == "" {
+		return errors.New("cart item query. product_id cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("cart item query. sort_direction cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *cartItemQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *cartItemQueryImplementation) SetColumns(columns []string) CartItemQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasCartID() bool {
+	return c.hasProperty("cart_id")
+}
+
+func (c *cartItemQueryImplementation) CartID() string {
+	if !c.HasCartID() {
+		return ""
+	}
+
+	return c.properties["cart_id"].(string)
+}
+
+func (c *cartItemQueryImplementation) SetCartID(cartID string) CartItemQueryInterface {
+	c.properties["cart_id"] = cartID
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *cartItemQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *cartItemQueryImplementation) SetCountOnly(countOnly bool) CartItemQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *cartItemQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *cartItemQueryImplementation) SetID(id string) CartItemQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *cartItemQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *cartItemQueryImplementation) SetLimit(limit int) CartItemQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *cartItemQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *cartItemQueryImplementation) SetOffset(offset int) CartItemQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *cartItemQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *cartItemQueryImplementation) SetOrderBy(orderBy string) CartItemQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasProductID() bool {
+	return c.hasProperty("product_id")
+}
+
+func (c *cartItemQueryImplementation) ProductID() string {
+	if !c.HasProductID() {
+		return ""
+	}
+
+	return c.properties["product_id"].(string)
+}
+
+func (c *cartItemQueryImplementation) SetProductID(productID string) CartItemQueryInterface {
+	c.properties["product_id"] = productID
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *cartItemQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *cartItemQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) CartItemQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *cartItemQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *cartItemQueryImplementation) SetSortDirection(sortDirection string) CartItemQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *cartItemQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -40,6 +40,14 @@ type CategoryQueryInterface interface {
 	ParentID() string
 	SetParentID(parentID string) CategoryQueryInterface
 
+	HasParentIDIn() bool
+	ParentIDIn() []string
+	SetParentIDIn(parentIDIn []string) CategoryQueryInterface
+
+	HasSlug() bool
+	Slug() string
+	SetSlug(slug string) CategoryQueryInterface
+
 	HasSoftDeletedIncluded() bool
 	SoftDeletedIncluded() bool
 	SetSoftDeletedIncluded(softDeletedIncluded bool) CategoryQueryInterface
@@ -78,6 +86,14 @@ func (c *categoryQueryImplementation) Validate() error {
 		return errors.New("category query. parent_id cannot be empty")
 	}
 
+	if c.HasParentIDIn() && len(c.ParentIDIn()) == 0 {
+		return errors.New("category query. parent_id_in cannot be empty")
+	}
+
+	if c.HasSlug() && c.Slug() == "" {
+		return errors.New("category query. slug cannot be empty")
+	}
+
 	if c.HasStatus() && c.Status() == "" {
 		return errors.New("category query. status cannot be empty")
 	}
@@ -263,6 +279,42 @@ func (c *categoryQueryImplementation) SetParentID(parentID string) CategoryQuery
 	return c
 }
 
+func (c *categoryQueryImplementation) HasParentIDIn() bool {
+	return c.hasProperty("parent_id_in")
+}
+
+func (c *categoryQueryImplementation) ParentIDIn() []string {
+	if !c.HasParentIDIn() {
+		return []string{}
+	}
+
+	return c.properties["parent_id_in"].([]string)
+}
+
+func (c *categoryQueryImplementation) SetParentIDIn(parentIDIn []string) CategoryQueryInterface {
+	c.properties["parent_id_in"] = parentIDIn
+
+	return c
+}
+
+func (c *categoryQueryImplementation) HasSlug() bool {
+	return c.hasProperty("slug")
+}
+
+func (c *categoryQueryImplementation) Slug() string {
+	if !c.HasSlug() {
+		return ""
+	}
+
+	return c.properties["slug"].(string)
+}
+
+func (c *categoryQueryImplementation) SetSlug(slug string) CategoryQueryInterface {
+	c.properties["slug"] = slug
+
+	return c
+}
+
 func (c *categoryQueryImplementation) HasSoftDeletedIncluded() bool {
 	return c.hasProperty("soft_deleted_included")
 }
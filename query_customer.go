@@ -0,0 +1,323 @@
+package shopstore
+
+import "errors"
+
+type CustomerQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) CustomerQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) CustomerQueryInterface
+
+	HasEmail() bool
+	Email() string
+	SetEmail(email string) CustomerQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) CustomerQueryInterface
+
+	HasIDIn() bool
+	IDIn() []string
+	SetIDIn(iDIn []string) CustomerQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) CustomerQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) CustomerQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) CustomerQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) CustomerQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) CustomerQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) CustomerQueryInterface
+
+	HasStatusIn() bool
+	StatusIn() []string
+	SetStatusIn(statusIn []string) CustomerQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewCustomerQuery() CustomerQueryInterface {
+	return &customerQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type customerQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *customerQueryImplementation) Validate() error {
+	if c.HasEmail() && c.Email() == "" {
+		return errors.New("customer query. email cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("customer query. id cannot be empty")
+	}
+
+	if c.HasIDIn() && len(c.IDIn()) == 0 {
+		return errors.New("customer query. id_in cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("customer query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("customer query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("customer query. order_by cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("customer query. sort_direction cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("customer query. status cannot be empty")
+	}
+
+	if c.HasStatusIn() && len(c.StatusIn()) == 0 {
+		return errors.New("customer query. status_in cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *customerQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *customerQueryImplementation) SetColumns(columns []string) CustomerQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *customerQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *customerQueryImplementation) SetCountOnly(countOnly bool) CustomerQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasEmail() bool {
+	return c.hasProperty("email")
+}
+
+func (c *customerQueryImplementation) Email() string {
+	if !c.HasEmail() {
+		return ""
+	}
+
+	return c.properties["email"].(string)
+}
+
+func (c *customerQueryImplementation) SetEmail(email string) CustomerQueryInterface {
+	c.properties["email"] = email
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *customerQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *customerQueryImplementation) SetID(id string) CustomerQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasIDIn() bool {
+	return c.hasProperty("id_in")
+}
+
+func (c *customerQueryImplementation) IDIn() []string {
+	if !c.HasIDIn() {
+		return []string{}
+	}
+
+	return c.properties["id_in"].([]string)
+}
+
+func (c *customerQueryImplementation) SetIDIn(iDIn []string) CustomerQueryInterface {
+	c.properties["id_in"] = iDIn
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *customerQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *customerQueryImplementation) SetLimit(limit int) CustomerQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *customerQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *customerQueryImplementation) SetOffset(offset int) CustomerQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *customerQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *customerQueryImplementation) SetOrderBy(orderBy string) CustomerQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *customerQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *customerQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) CustomerQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *customerQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *customerQueryImplementation) SetSortDirection(sortDirection string) CustomerQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *customerQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *customerQueryImplementation) SetStatus(status string) CustomerQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *customerQueryImplementation) HasStatusIn() bool {
+	return c.hasProperty("status_in")
+}
+
+func (c *customerQueryImplementation) StatusIn() []string {
+	if !c.HasStatusIn() {
+		return []string{}
+	}
+
+	return c.properties["status_in"].([]string)
+}
+
+func (c *customerQueryImplementation) SetStatusIn(statusIn []string) CustomerQueryInterface {
+	c.properties["status_in"] = statusIn
+
+	return c
+}
+
+func (c *customerQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,275 @@
+package shopstore
+
+import "errors"
+
+type DiscountRedemptionQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) DiscountRedemptionQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) DiscountRedemptionQueryInterface
+
+	HasCustomerID() bool
+	CustomerID() string
+	SetCustomerID(customerID string) DiscountRedemptionQueryInterface
+
+	HasDiscountID() bool
+	DiscountID() string
+	SetDiscountID(discountID string) DiscountRedemptionQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) DiscountRedemptionQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) DiscountRedemptionQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) DiscountRedemptionQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) DiscountRedemptionQueryInterface
+
+	HasOrderID() bool
+	OrderID() string
+	SetOrderID(orderID string) DiscountRedemptionQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) DiscountRedemptionQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewDiscountRedemptionQuery() DiscountRedemptionQueryInterface {
+	return &discountRedemptionQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type discountRedemptionQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *discountRedemptionQueryImplementation) Validate() error {
+	if c.HasCustomerID() && c.CustomerID() == "" {
+		return errors.New("discount redemption query. customer_id cannot be empty")
+	}
+
+	if c.HasDiscountID() && c.DiscountID() == "" {
+		return errors.New("discount redemption query. discount_id cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("discount redemption query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("discount redemption query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("discount redemption query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("discount redemption query. order_by cannot be empty")
+	}
+
+	if c.HasOrderID() && c.OrderID() == "" {
+		return errors.New("discount redemption query. order_id cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("discount redemption query. sort_direction cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *discountRedemptionQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetColumns(columns []string) DiscountRedemptionQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *discountRedemptionQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *discountRedemptionQueryImplementation) SetCountOnly(countOnly bool) DiscountRedemptionQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasCustomerID() bool {
+	return c.hasProperty("customer_id")
+}
+
+func (c *discountRedemptionQueryImplementation) CustomerID() string {
+	if !c.HasCustomerID() {
+		return ""
+	}
+
+	return c.properties["customer_id"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetCustomerID(customerID string) DiscountRedemptionQueryInterface {
+	c.properties["customer_id"] = customerID
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasDiscountID() bool {
+	return c.hasProperty("discount_id")
+}
+
+func (c *discountRedemptionQueryImplementation) DiscountID() string {
+	if !c.HasDiscountID() {
+		return ""
+	}
+
+	return c.properties["discount_id"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetDiscountID(discountID string) DiscountRedemptionQueryInterface {
+	c.properties["discount_id"] = discountID
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *discountRedemptionQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetID(id string) DiscountRedemptionQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *discountRedemptionQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *discountRedemptionQueryImplementation) SetLimit(limit int) DiscountRedemptionQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *discountRedemptionQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *discountRedemptionQueryImplementation) SetOffset(offset int) DiscountRedemptionQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *discountRedemptionQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetOrderBy(orderBy string) DiscountRedemptionQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasOrderID() bool {
+	return c.hasProperty("order_id")
+}
+
+func (c *discountRedemptionQueryImplementation) OrderID() string {
+	if !c.HasOrderID() {
+		return ""
+	}
+
+	return c.properties["order_id"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetOrderID(orderID string) DiscountRedemptionQueryInterface {
+	c.properties["order_id"] = orderID
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *discountRedemptionQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *discountRedemptionQueryImplementation) SetSortDirection(sortDirection string) DiscountRedemptionQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *discountRedemptionQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,375 @@
+package shopstore
+
+import "errors"
+
+type PaymentQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) PaymentQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) PaymentQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) PaymentQueryInterface
+
+	HasIDIn() bool
+	IDIn() []string
+	SetIDIn(iDIn []string) PaymentQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) PaymentQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) PaymentQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) PaymentQueryInterface
+
+	HasOrderID() bool
+	OrderID() string
+	SetOrderID(orderID string) PaymentQueryInterface
+
+	HasProvider() bool
+	Provider() string
+	SetProvider(provider string) PaymentQueryInterface
+
+	HasProviderReference() bool
+	ProviderReference() string
+	SetProviderReference(providerReference string) PaymentQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) PaymentQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) PaymentQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) PaymentQueryInterface
+
+	HasStatusIn() bool
+	StatusIn() []string
+	SetStatusIn(statusIn []string) PaymentQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewPaymentQuery() PaymentQueryInterface {
+	return &paymentQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type paymentQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *paymentQueryImplementation) Validate() error {
+	if c.HasID() && c.ID() == "" {
+		return errors.New("payment query. id cannot be empty")
+	}
+
+	if c.HasIDIn() && len(c.IDIn()) == 0 {
+		return errors.New("payment query. id_in cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("payment query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("payment query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("payment query. order_by cannot be empty")
+	}
+
+	if c.HasOrderID() && c.OrderID() == "" {
+		return errors.New("payment query. order_id cannot be empty")
+	}
+
+	if c.HasProvider() && c.Provider() == "" {
+		return errors.New("payment query. provider cannot be empty")
+	}
+
+	if c.HasProviderReference() && c.ProviderReference() == "" {
+		return errors.New("payment query. provider_reference cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("payment query. sort_direction cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("payment query. status cannot be empty")
+	}
+
+	if c.HasStatusIn() && len(c.StatusIn()) == 0 {
+		return errors.New("payment query. status_in cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *paymentQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *paymentQueryImplementation) SetColumns(columns []string) PaymentQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *paymentQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *paymentQueryImplementation) SetCountOnly(countOnly bool) PaymentQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *paymentQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *paymentQueryImplementation) SetID(id string) PaymentQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasIDIn() bool {
+	return c.hasProperty("id_in")
+}
+
+func (c *paymentQueryImplementation) IDIn() []string {
+	if !c.HasIDIn() {
+		return []string{}
+	}
+
+	return c.properties["id_in"].([]string)
+}
+
+func (c *paymentQueryImplementation) SetIDIn(iDIn []string) PaymentQueryInterface {
+	c.properties["id_in"] = iDIn
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *paymentQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *paymentQueryImplementation) SetLimit(limit int) PaymentQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *paymentQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *paymentQueryImplementation) SetOffset(offset int) PaymentQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *paymentQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *paymentQueryImplementation) SetOrderBy(orderBy string) PaymentQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasOrderID() bool {
+	return c.hasProperty("order_id")
+}
+
+func (c *paymentQueryImplementation) OrderID() string {
+	if !c.HasOrderID() {
+		return ""
+	}
+
+	return c.properties["order_id"].(string)
+}
+
+func (c *paymentQueryImplementation) SetOrderID(orderID string) PaymentQueryInterface {
+	c.properties["order_id"] = orderID
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasProvider() bool {
+	return c.hasProperty("provider")
+}
+
+func (c *paymentQueryImplementation) Provider() string {
+	if !c.HasProvider() {
+		return ""
+	}
+
+	return c.properties["provider"].(string)
+}
+
+func (c *paymentQueryImplementation) SetProvider(provider string) PaymentQueryInterface {
+	c.properties["provider"] = provider
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasProviderReference() bool {
+	return c.hasProperty("provider_reference")
+}
+
+func (c *paymentQueryImplementation) ProviderReference() string {
+	if !c.HasProviderReference() {
+		return ""
+	}
+
+	return c.properties["provider_reference"].(string)
+}
+
+func (c *paymentQueryImplementation) SetProviderReference(providerReference string) PaymentQueryInterface {
+	c.properties["provider_reference"] = providerReference
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *paymentQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *paymentQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) PaymentQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *paymentQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *paymentQueryImplementation) SetSortDirection(sortDirection string) PaymentQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *paymentQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *paymentQueryImplementation) SetStatus(status string) PaymentQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *paymentQueryImplementation) HasStatusIn() bool {
+	return c.hasProperty("status_in")
+}
+
+func (c *paymentQueryImplementation) StatusIn() []string {
+	if !c.HasStatusIn() {
+		return []string{}
+	}
+
+	return c.properties["status_in"].([]string)
+}
+
+func (c *paymentQueryImplementation) SetStatusIn(statusIn []string) PaymentQueryInterface {
+	c.properties["status_in"] = statusIn
+
+	return c
+}
+
+func (c *paymentQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
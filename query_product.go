@@ -1,10 +1,41 @@
 package shopstore
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 type ProductQueryInterface interface {
 	Validate() error
 
+	HasAttributeGte() bool
+	AttributeGte() map[string]float64
+	SetAttributeGte(attributeGte map[string]float64) ProductQueryInterface
+
+	HasAttributeLte() bool
+	AttributeLte() map[string]float64
+	SetAttributeLte(attributeLte map[string]float64) ProductQueryInterface
+
+	HasAttributeValues() bool
+	AttributeValues() map[string][]string
+	SetAttributeValues(attributeValues map[string][]string) ProductQueryInterface
+
+	HasBarcode() bool
+	Barcode() string
+	SetBarcode(barcode string) ProductQueryInterface
+
+	HasCategoryDescendantsIncluded() bool
+	CategoryDescendantsIncluded() bool
+	SetCategoryDescendantsIncluded(categoryDescendantsIncluded bool) ProductQueryInterface
+
+	HasCategoryID() bool
+	CategoryID() string
+	SetCategoryID(categoryID string) ProductQueryInterface
+
+	HasCategoryIDIn() bool
+	CategoryIDIn() []string
+	SetCategoryIDIn(categoryIDIn []string) ProductQueryInterface
+
 	Columns() []string
 	SetColumns(columns []string) ProductQueryInterface
 
@@ -28,6 +59,10 @@ type ProductQueryInterface interface {
 	IDIn() []string
 	SetIDIn(idIn []string) ProductQueryInterface
 
+	HasInStock() bool
+	InStock() bool
+	SetInStock(inStock bool) ProductQueryInterface
+
 	HasLimit() bool
 	Limit() int
 	SetLimit(limit int) ProductQueryInterface
@@ -40,6 +75,30 @@ type ProductQueryInterface interface {
 	OrderBy() string
 	SetOrderBy(orderBy string) ProductQueryInterface
 
+	HasPriceGte() bool
+	PriceGte() Money
+	SetPriceGte(priceGte Money) ProductQueryInterface
+
+	HasPriceLte() bool
+	PriceLte() Money
+	SetPriceLte(priceLte Money) ProductQueryInterface
+
+	HasSearch() bool
+	Search() string
+	SetSearch(search string) ProductQueryInterface
+
+	HasSKU() bool
+	SKU() string
+	SetSKU(sku string) ProductQueryInterface
+
+	HasSKUIn() bool
+	SKUIn() []string
+	SetSKUIn(skuIn []string) ProductQueryInterface
+
+	HasSlug() bool
+	Slug() string
+	SetSlug(slug string) ProductQueryInterface
+
 	HasSortDirection() bool
 	SortDirection() string
 	SetSortDirection(sortDirection string) ProductQueryInterface
@@ -75,6 +134,36 @@ type productQueryImplementation struct {
 
 func (c *productQueryImplementation) Validate() error {
 
+	if c.HasAttributeGte() && len(c.AttributeGte()) == 0 {
+		return errors.New("product query. attribute_gte cannot be empty")
+	}
+
+	if c.HasAttributeLte() && len(c.AttributeLte()) == 0 {
+		return errors.New("product query. attribute_lte cannot be empty")
+	}
+
+	if c.HasAttributeValues() && len(c.AttributeValues()) == 0 {
+		return errors.New("product query. attribute_values cannot be empty")
+	}
+
+	for name, values := range c.AttributeValues() {
+		if len(values) == 0 {
+			return errors.New("product query. attribute_values of " + name + " cannot be empty")
+		}
+	}
+
+	if c.HasBarcode() && c.Barcode() == "" {
+		return errors.New("product query. barcode cannot be empty")
+	}
+
+	if c.HasCategoryID() && c.CategoryID() == "" {
+		return errors.New("product query. category_id cannot be empty")
+	}
+
+	if c.HasCategoryIDIn() && len(c.CategoryIDIn()) == 0 {
+		return errors.New("product query. category_id_in cannot be empty")
+	}
+
 	if c.HasCreatedAtGte() && c.CreatedAtGte() == "" {
 		return errors.New("product query. created_at_gte cannot be empty")
 	}
@@ -91,6 +180,22 @@ func (c *productQueryImplementation) Validate() error {
 		return errors.New("product query. id_in cannot be empty")
 	}
 
+	if c.HasSearch() && strings.TrimSpace(c.Search()) == "" {
+		return errors.New("product query. search cannot be empty")
+	}
+
+	if c.HasSKU() && c.SKU() == "" {
+		return errors.New("product query. sku cannot be empty")
+	}
+
+	if c.HasSKUIn() && len(c.SKUIn()) == 0 {
+		return errors.New("product query. sku_in cannot be empty")
+	}
+
+	if c.HasSlug() && c.Slug() == "" {
+		return errors.New("product query. slug cannot be empty")
+	}
+
 	if c.HasSortDirection() && c.SortDirection() == "" {
 		return errors.New("product query. sort_direction cannot be empty")
 	}
@@ -107,6 +212,18 @@ func (c *productQueryImplementation) Validate() error {
 		return errors.New("product query. order_by cannot be empty")
 	}
 
+	if c.HasPriceGte() && c.PriceGte().Currency() == "" {
+		return errors.New("product query. price_gte currency cannot be empty")
+	}
+
+	if c.HasPriceLte() && c.PriceLte().Currency() == "" {
+		return errors.New("product query. price_lte currency cannot be empty")
+	}
+
+	if c.HasPriceGte() && c.HasPriceLte() && c.PriceGte().Currency() != c.PriceLte().Currency() {
+		return errors.New("product query. price_gte and price_lte must be in the same currency")
+	}
+
 	if c.HasStatus() && c.Status() == "" {
 		return errors.New("product query. status cannot be empty")
 	}
@@ -122,6 +239,138 @@ func (c *productQueryImplementation) Validate() error {
 	return nil
 }
 
+func (c *productQueryImplementation) HasAttributeGte() bool {
+	return c.hasProperty("attribute_gte")
+}
+
+func (c *productQueryImplementation) AttributeGte() map[string]float64 {
+	if !c.HasAttributeGte() {
+		return map[string]float64{}
+	}
+
+	return c.properties["attribute_gte"].(map[string]float64)
+}
+
+// SetAttributeGte matches the products with a numeric value of each of
+// the attributes greater than or equal to the one set, by attribute name
+func (c *productQueryImplementation) SetAttributeGte(attributeGte map[string]float64) ProductQueryInterface {
+	c.properties["attribute_gte"] = attributeGte
+
+	return c
+}
+
+func (c *productQueryImplementation) HasAttributeLte() bool {
+	return c.hasProperty("attribute_lte")
+}
+
+func (c *productQueryImplementation) AttributeLte() map[string]float64 {
+	if !c.HasAttributeLte() {
+		return map[string]float64{}
+	}
+
+	return c.properties["attribute_lte"].(map[string]float64)
+}
+
+// SetAttributeLte matches the products with a numeric value of each of
+// the attributes less than or equal to the one set, by attribute name
+func (c *productQueryImplementation) SetAttributeLte(attributeLte map[string]float64) ProductQueryInterface {
+	c.properties["attribute_lte"] = attributeLte
+
+	return c
+}
+
+func (c *productQueryImplementation) HasAttributeValues() bool {
+	return c.hasProperty("attribute_values")
+}
+
+func (c *productQueryImplementation) AttributeValues() map[string][]string {
+	if !c.HasAttributeValues() {
+		return map[string][]string{}
+	}
+
+	return c.properties["attribute_values"].(map[string][]string)
+}
+
+// SetAttributeValues matches the products having, for each of the
+// attribute names, any of the values, i.e. colour red or blue
+func (c *productQueryImplementation) SetAttributeValues(attributeValues map[string][]string) ProductQueryInterface {
+	c.properties["attribute_values"] = attributeValues
+
+	return c
+}
+
+func (c *productQueryImplementation) HasBarcode() bool {
+	return c.hasProperty("barcode")
+}
+
+func (c *productQueryImplementation) Barcode() string {
+	if !c.HasBarcode() {
+		return ""
+	}
+
+	return c.properties["barcode"].(string)
+}
+
+func (c *productQueryImplementation) SetBarcode(barcode string) ProductQueryInterface {
+	c.properties["barcode"] = barcode
+
+	return c
+}
+
+func (c *productQueryImplementation) HasCategoryDescendantsIncluded() bool {
+	return c.hasProperty("category_descendants_included")
+}
+
+func (c *productQueryImplementation) CategoryDescendantsIncluded() bool {
+	if !c.HasCategoryDescendantsIncluded() {
+		return false
+	}
+
+	return c.properties["category_descendants_included"].(bool)
+}
+
+func (c *productQueryImplementation) SetCategoryDescendantsIncluded(categoryDescendantsIncluded bool) ProductQueryInterface {
+	c.properties["category_descendants_included"] = categoryDescendantsIncluded
+
+	return c
+}
+
+func (c *productQueryImplementation) HasCategoryID() bool {
+	return c.hasProperty("category_id")
+}
+
+func (c *productQueryImplementation) CategoryID() string {
+	if !c.HasCategoryID() {
+		return ""
+	}
+
+	return c.properties["category_id"].(string)
+}
+
+func (c *productQueryImplementation) SetCategoryID(categoryID string) ProductQueryInterface {
+	c.properties["category_id"] = categoryID
+
+	return c
+}
+
+func (c *productQueryImplementation) HasCategoryIDIn() bool {
+	return c.hasProperty("category_id_in")
+}
+
+func (c *productQueryImplementation) CategoryIDIn() []string {
+	if !c.HasCategoryIDIn() {
+		return []string{}
+	}
+
+	return c.properties["category_id_in"].([]string)
+}
+
+func (c *productQueryImplementation) SetCategoryIDIn(categoryIDIn []string) ProductQueryInterface {
+	c.properties["category_id_in"] = categoryIDIn
+
+	return c
+}
+
 func (c *productQueryImplementation) Columns() []string {
 	if !c.hasProperty("columns") {
 		return []string{}
@@ -226,6 +475,27 @@ func (c *productQueryImplementation) SetIDIn(idIn []string) ProductQueryInterfac
 	return c
 }
 
+func (c *productQueryImplementation) HasInStock() bool {
+	return c.hasProperty("in_stock")
+}
+
+func (c *productQueryImplementation) InStock() bool {
+	if !c.HasInStock() {
+		return false
+	}
+
+	return c.properties["in_stock"].(bool)
+}
+
+// SetInStock matches the products with a quantity available, not held
+// by stock reservations, or with a variant in stock. If false, it
+// matches the products out of stock.
+func (c *productQueryImplementation) SetInStock(inStock bool) ProductQueryInterface {
+	c.properties["in_stock"] = inStock
+
+	return c
+}
+
 func (c *productQueryImplementation) HasLimit() bool {
 	return c.hasProperty("limit")
 }
@@ -280,6 +550,121 @@ func (c *productQueryImplementation) SetOrderBy(orderBy string) ProductQueryInte
 	return c
 }
 
+func (c *productQueryImplementation) HasPriceGte() bool {
+	return c.hasProperty("price_gte")
+}
+
+func (c *productQueryImplementation) PriceGte() Money {
+	if !c.HasPriceGte() {
+		return Money{}
+	}
+
+	return c.properties["price_gte"].(Money)
+}
+
+// SetPriceGte matches the products priced at least the amount in its
+// currency, by their price set for the currency or else their own price
+func (c *productQueryImplementation) SetPriceGte(priceGte Money) ProductQueryInterface {
+	c.properties["price_gte"] = priceGte
+
+	return c
+}
+
+func (c *productQueryImplementation) HasPriceLte() bool {
+	return c.hasProperty("price_lte")
+}
+
+func (c *productQueryImplementation) PriceLte() Money {
+	if !c.HasPriceLte() {
+		return Money{}
+	}
+
+	return c.properties["price_lte"].(Money)
+}
+
+// SetPriceLte matches the products priced at most the amount in its
+// currency, by their price set for the currency or else their own price
+func (c *productQueryImplementation) SetPriceLte(priceLte Money) ProductQueryInterface {
+	c.properties["price_lte"] = priceLte
+
+	return c
+}
+
+func (c *productQueryImplementation) HasSearch() bool {
+	return c.hasProperty("search")
+}
+
+func (c *productQueryImplementation) Search() string {
+	if !c.HasSearch() {
+		return ""
+	}
+
+	return c.properties["search"].(string)
+}
+
+// SetSearch matches the products by the words of the search in the title,
+// short description, description or metas, the most relevant first
+// unless ordered otherwise (see Store.productSearch)
+func (c *productQueryImplementation) SetSearch(search string) ProductQueryInterface {
+	c.properties["search"] = search
+
+	return c
+}
+
+func (c *productQueryImplementation) HasSKU() bool {
+	return c.hasProperty("sku")
+}
+
+func (c *productQueryImplementation) SKU() string {
+	if !c.HasSKU() {
+		return ""
+	}
+
+	return c.properties["sku"].(string)
+}
+
+func (c *productQueryImplementation) SetSKU(sku string) ProductQueryInterface {
+	c.properties["sku"] = sku
+
+	return c
+}
+
+func (c *productQueryImplementation) HasSKUIn() bool {
+	return c.hasProperty("sku_in")
+}
+
+func (c *productQueryImplementation) SKUIn() []string {
+	if !c.HasSKUIn() {
+		return []string{}
+	}
+
+	return c.properties["sku_in"].([]string)
+}
+
+func (c *productQueryImplementation) SetSKUIn(skuIn []string) ProductQueryInterface {
+	c.properties["sku_in"] = skuIn
+
+	return c
+}
+
+func (c *productQueryImplementation) HasSlug() bool {
+	return c.hasProperty("slug")
+}
+
+func (c *productQueryImplementation) Slug() string {
+	if !c.HasSlug() {
+		return ""
+	}
+
+	return c.properties["slug"].(string)
+}
+
+func (c *productQueryImplementation) SetSlug(slug string) ProductQueryInterface {
+	c.properties["slug"] = slug
+
+	return c
+}
+
 func (c *productQueryImplementation) HasSortDirection() bool {
 	return c.hasProperty("sort_direction")
 }
@@ -0,0 +1,323 @@
+package shopstore
+
+import "errors"
+
+type ProductVariantQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) ProductVariantQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) ProductVariantQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) ProductVariantQueryInterface
+
+	HasIDIn() bool
+	IDIn() []string
+	SetIDIn(iDIn []string) ProductVariantQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) ProductVariantQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) ProductVariantQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) ProductVariantQueryInterface
+
+	HasProductID() bool
+	ProductID() string
+	SetProductID(productID string) ProductVariantQueryInterface
+
+	HasSKU() bool
+	SKU() string
+	SetSKU(sku string) ProductVariantQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) ProductVariantQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) ProductVariantQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) ProductVariantQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewProductVariantQuery() ProductVariantQueryInterface {
+	return &productVariantQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type productVariantQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *productVariantQueryImplementation) Validate() error {
+	if c.HasID() && c.ID() == "" {
+		return errors.New("product variant query. id cannot be empty")
+	}
+
+	if c.HasIDIn() && len(c.IDIn()) == 0 {
+		return errors.New("product variant query. id_in cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("product variant query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("product variant query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("product variant query. order_by cannot be empty")
+	}
+
+	if c.HasProductID() && c.ProductID() == "" {
+		return errors.New("product variant query. product_id cannot be empty")
+	}
+
+	if c.HasSKU() && c.SKU() == "" {
+		return errors.New("product variant query. sku cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("product variant query. sort_direction cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("product variant query. status cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *productVariantQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *productVariantQueryImplementation) SetColumns(columns []string) ProductVariantQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *productVariantQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *productVariantQueryImplementation) SetCountOnly(countOnly bool) ProductVariantQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *productVariantQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetID(id string) ProductVariantQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasIDIn() bool {
+	return c.hasProperty("id_in")
+}
+
+func (c *productVariantQueryImplementation) IDIn() []string {
+	if !c.HasIDIn() {
+		return []string{}
+	}
+
+	return c.properties["id_in"].([]string)
+}
+
+func (c *productVariantQueryImplementation) SetIDIn(iDIn []string) ProductVariantQueryInterface {
+	c.properties["id_in"] = iDIn
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *productVariantQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *productVariantQueryImplementation) SetLimit(limit int) ProductVariantQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *productVariantQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *productVariantQueryImplementation) SetOffset(offset int) ProductVariantQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *productVariantQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetOrderBy(orderBy string) ProductVariantQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasProductID() bool {
+	return c.hasProperty("product_id")
+}
+
+func (c *productVariantQueryImplementation) ProductID() string {
+	if !c.HasProductID() {
+		return ""
+	}
+
+	return c.properties["product_id"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetProductID(productID string) ProductVariantQueryInterface {
+	c.properties["product_id"] = productID
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasSKU() bool {
+	return c.hasProperty("sku")
+}
+
+func (c *productVariantQueryImplementation) SKU() string {
+	if !c.HasSKU() {
+		return ""
+	}
+
+	return c.properties["sku"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetSKU(sku string) ProductVariantQueryInterface {
+	c.properties["sku"] = sku
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *productVariantQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *productVariantQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) ProductVariantQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *productVariantQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetSortDirection(sortDirection string) ProductVariantQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *productVariantQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *productVariantQueryImplementation) SetStatus(status string) ProductVariantQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *productVariantQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,249 @@
+package shopstore
+
+import "errors"
+
+type RefundQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) RefundQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) RefundQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) RefundQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) RefundQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) RefundQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) RefundQueryInterface
+
+	HasOrderID() bool
+	OrderID() string
+	SetOrderID(orderID string) RefundQueryInterface
+
+	HasOrderLineItemID() bool
+	OrderLineItemID() string
+	SetOrderLineItemID(orderLineItemID string) RefundQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) RefundQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewRefundQuery() RefundQueryInterface {
+	return &refundQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type refundQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *refundQueryImplementation) Validate() error {
+	if c.HasID() && c.ID() == "" {
+		return errors.New("refund query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("refund query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("refund query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("refund query. order_by cannot be empty")
+	}
+
+	if c.HasOrderID() && c.OrderID() == "" {
+		return errors.New("refund query. order_id cannot be empty")
+	}
+
+	if c.HasOrderLineItemID() && c.OrderLineItemID() == "" {
+		return errors.New("refund query. order_line_item_id cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("refund query. sort_direction cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *refundQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *refundQueryImplementation) SetColumns(columns []string) RefundQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *refundQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *refundQueryImplementation) SetCountOnly(countOnly bool) RefundQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *refundQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *refundQueryImplementation) SetID(id string) RefundQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *refundQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *refundQueryImplementation) SetLimit(limit int) RefundQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *refundQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *refundQueryImplementation) SetOffset(offset int) RefundQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *refundQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *refundQueryImplementation) SetOrderBy(orderBy string) RefundQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasOrderID() bool {
+	return c.hasProperty("order_id")
+}
+
+func (c *refundQueryImplementation) OrderID() string {
+	if !c.HasOrderID() {
+		return ""
+	}
+
+	return c.properties["order_id"].(string)
+}
+
+func (c *refundQueryImplementation) SetOrderID(orderID string) RefundQueryInterface {
+	c.properties["order_id"] = orderID
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasOrderLineItemID() bool {
+	return c.hasProperty("order_line_item_id")
+}
+
+func (c *refundQueryImplementation) OrderLineItemID() string {
+	if !c.HasOrderLineItemID() {
+		return ""
+	}
+
+	return c.properties["order_line_item_id"].(string)
+}
+
+func (c *refundQueryImplementation) SetOrderLineItemID(orderLineItemID string) RefundQueryInterface {
+	c.properties["order_line_item_id"] = orderLineItemID
+
+	return c
+}
+
+func (c *refundQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *refundQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *refundQueryImplementation) SetSortDirection(sortDirection string) RefundQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *refundQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,245 @@
+package shopstore
+
+import "errors"
+
+type ShipmentQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) ShipmentQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) ShipmentQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) ShipmentQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) ShipmentQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) ShipmentQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) ShipmentQueryInterface
+
+	HasOrderID() bool
+	OrderID() string
+	SetOrderID(orderID string) ShipmentQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) ShipmentQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) ShipmentQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewShipmentQuery() ShipmentQueryInterface {
+	return &shipmentQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type shipmentQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *shipmentQueryImplementation) Validate() error {
+	if c.HasID() && c.ID() == "" {
+		return errors.New("shipment query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("shipment query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("shipment query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("shipment query. order_by cannot be empty")
+	}
+
+	if c.HasOrderID() && c.OrderID() == "" {
+		return errors.New("shipment query. order_id cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("shipment query. sort_direction cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *shipmentQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *shipmentQueryImplementation) SetColumns(columns []string) ShipmentQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *shipmentQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *shipmentQueryImplementation) SetCountOnly(countOnly bool) ShipmentQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *shipmentQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *shipmentQueryImplementation) SetID(id string) ShipmentQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *shipmentQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *shipmentQueryImplementation) SetLimit(limit int) ShipmentQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *shipmentQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *shipmentQueryImplementation) SetOffset(offset int) ShipmentQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *shipmentQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *shipmentQueryImplementation) SetOrderBy(orderBy string) ShipmentQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasOrderID() bool {
+	return c.hasProperty("order_id")
+}
+
+func (c *shipmentQueryImplementation) OrderID() string {
+	if !c.HasOrderID() {
+		return ""
+	}
+
+	return c.properties["order_id"].(string)
+}
+
+func (c *shipmentQueryImplementation) SetOrderID(orderID string) ShipmentQueryInterface {
+	c.properties["order_id"] = orderID
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *shipmentQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *shipmentQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) ShipmentQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *shipmentQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *shipmentQueryImplementation) SetSortDirection(sortDirection string) ShipmentQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *shipmentQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,376 @@
+package shopstore
+
+import "errors"
+
+type ShippingMethodQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) ShippingMethodQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) ShippingMethodQueryInterface
+
+	HasCreatedAtGte() bool
+	CreatedAtGte() string
+	SetCreatedAtGte(createdAtGte string) ShippingMethodQueryInterface
+
+	HasCreatedAtLte() bool
+	CreatedAtLte() string
+	SetCreatedAtLte(createdAtLte string) ShippingMethodQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) ShippingMethodQueryInterface
+
+	HasIDIn() bool
+	IDIn() []string
+	SetIDIn(idIn []string) ShippingMethodQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) ShippingMethodQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) ShippingMethodQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) ShippingMethodQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) ShippingMethodQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) ShippingMethodQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) ShippingMethodQueryInterface
+
+	HasStatusIn() bool
+	StatusIn() []string
+	SetStatusIn(statusIn []string) ShippingMethodQueryInterface
+
+	HasType() bool
+	Type() string
+	SetType(type_ string) ShippingMethodQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewShippingMethodQuery() ShippingMethodQueryInterface {
+	return &shippingMethodQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type shippingMethodQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *shippingMethodQueryImplementation) Validate() error {
+
+	if c.HasCreatedAtGte() && c.CreatedAtGte() == "" {
+		return errors.New("shipping method query. created_at_gte cannot be empty")
+	}
+
+	if c.HasCreatedAtLte() && c.CreatedAtLte() == "" {
+		return errors.New("shipping method query. created_at_lte cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("shipping method query. id cannot be empty")
+	}
+
+	if c.HasIDIn() && len(c.IDIn()) == 0 {
+		return errors.New("shipping method query. id_in cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("shipping method query. sort_direction cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("shipping method query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("shipping method query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("shipping method query. order_by cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("shipping method query. status cannot be empty")
+	}
+
+	if c.HasStatusIn() && len(c.StatusIn()) == 0 {
+		return errors.New("shipping method query. status_in cannot be empty")
+	}
+
+	if c.HasType() && c.Type() == "" {
+		return errors.New("shipping method query. type cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *shippingMethodQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *shippingMethodQueryImplementation) SetColumns(columns []string) ShippingMethodQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *shippingMethodQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *shippingMethodQueryImplementation) SetCountOnly(countOnly bool) ShippingMethodQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasCreatedAtGte() bool {
+	return c.hasProperty("created_at_gte")
+}
+
+func (c *shippingMethodQueryImplementation) CreatedAtGte() string {
+	if !c.HasCreatedAtGte() {
+		return ""
+	}
+
+	return c.properties["created_at_gte"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetCreatedAtGte(createdAtGte string) ShippingMethodQueryInterface {
+	c.properties["created_at_gte"] = createdAtGte
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasCreatedAtLte() bool {
+	return c.hasProperty("created_at_lte")
+}
+
+func (c *shippingMethodQueryImplementation) CreatedAtLte() string {
+	if !c.HasCreatedAtLte() {
+		return ""
+	}
+
+	return c.properties["created_at_lte"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetCreatedAtLte(createdAtLte string) ShippingMethodQueryInterface {
+	c.properties["created_at_lte"] = createdAtLte
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *shippingMethodQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetID(id string) ShippingMethodQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasIDIn() bool {
+	return c.hasProperty("id_in")
+}
+
+func (c *shippingMethodQueryImplementation) IDIn() []string {
+	if !c.HasIDIn() {
+		return []string{}
+	}
+
+	return c.properties["id_in"].([]string)
+}
+
+func (c *shippingMethodQueryImplementation) SetIDIn(idIn []string) ShippingMethodQueryInterface {
+	c.properties["id_in"] = idIn
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *shippingMethodQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *shippingMethodQueryImplementation) SetLimit(limit int) ShippingMethodQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *shippingMethodQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *shippingMethodQueryImplementation) SetOffset(offset int) ShippingMethodQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *shippingMethodQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetOrderBy(orderBy string) ShippingMethodQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *shippingMethodQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetSortDirection(sortDirection string) ShippingMethodQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *shippingMethodQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *shippingMethodQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) ShippingMethodQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *shippingMethodQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetStatus(status string) ShippingMethodQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasStatusIn() bool {
+	return c.hasProperty("status_in")
+}
+
+func (c *shippingMethodQueryImplementation) StatusIn() []string {
+	if !c.HasStatusIn() {
+		return []string{}
+	}
+
+	return c.properties["status_in"].([]string)
+}
+
+func (c *shippingMethodQueryImplementation) SetStatusIn(statusIn []string) ShippingMethodQueryInterface {
+	c.properties["status_in"] = statusIn
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) HasType() bool {
+	return c.hasProperty("type")
+}
+
+func (c *shippingMethodQueryImplementation) Type() string {
+	if !c.HasType() {
+		return ""
+	}
+
+	return c.properties["type"].(string)
+}
+
+func (c *shippingMethodQueryImplementation) SetType(type_ string) ShippingMethodQueryInterface {
+	c.properties["type"] = type_
+
+	return c
+}
+
+func (c *shippingMethodQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,275 @@
+package shopstore
+
+import "errors"
+
+type StockReservationQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) StockReservationQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) StockReservationQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) StockReservationQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) StockReservationQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) StockReservationQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) StockReservationQueryInterface
+
+	HasOrderID() bool
+	OrderID() string
+	SetOrderID(orderID string) StockReservationQueryInterface
+
+	HasProductID() bool
+	ProductID() string
+	SetProductID(productID string) StockReservationQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) StockReservationQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) StockReservationQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewStockReservationQuery() StockReservationQueryInterface {
+	return &stockReservationQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type stockReservationQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *stockReservationQueryImplementation) Validate() error {
+	if c.HasID() && c.ID() == "" {
+		return errors.New("stock reservation query. id cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("stock reservation query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("stock reservation query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("stock reservation query. order_by cannot be empty")
+	}
+
+	if c.HasOrderID() && c.OrderID() == "" {
+		return errors.New("stock reservation query. order_id cannot be empty")
+	}
+
+	if c.HasProductID() && c.ProductID() == "" {
+		return errors.New("stock reservation query. product_id cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("stock reservation query. sort_direction cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("stock reservation query. status cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *stockReservationQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *stockReservationQueryImplementation) SetColumns(columns []string) StockReservationQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *stockReservationQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *stockReservationQueryImplementation) SetCountOnly(countOnly bool) StockReservationQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *stockReservationQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetID(id string) StockReservationQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *stockReservationQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *stockReservationQueryImplementation) SetLimit(limit int) StockReservationQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *stockReservationQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *stockReservationQueryImplementation) SetOffset(offset int) StockReservationQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *stockReservationQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetOrderBy(orderBy string) StockReservationQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasOrderID() bool {
+	return c.hasProperty("order_id")
+}
+
+func (c *stockReservationQueryImplementation) OrderID() string {
+	if !c.HasOrderID() {
+		return ""
+	}
+
+	return c.properties["order_id"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetOrderID(orderID string) StockReservationQueryInterface {
+	c.properties["order_id"] = orderID
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasProductID() bool {
+	return c.hasProperty("product_id")
+}
+
+func (c *stockReservationQueryImplementation) ProductID() string {
+	if !c.HasProductID() {
+		return ""
+	}
+
+	return c.properties["product_id"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetProductID(productID string) StockReservationQueryInterface {
+	c.properties["product_id"] = productID
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *stockReservationQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetSortDirection(sortDirection string) StockReservationQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *stockReservationQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *stockReservationQueryImplementation) SetStatus(status string) StockReservationQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *stockReservationQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
@@ -0,0 +1,402 @@
+package shopstore
+
+import "errors"
+
+type TaxRateQueryInterface interface {
+	Validate() error
+
+	Columns() []string
+	SetColumns(columns []string) TaxRateQueryInterface
+
+	HasCategoryID() bool
+	CategoryID() string
+	SetCategoryID(categoryID string) TaxRateQueryInterface
+
+	HasCountOnly() bool
+	IsCountOnly() bool
+	SetCountOnly(countOnly bool) TaxRateQueryInterface
+
+	HasCreatedAtGte() bool
+	CreatedAtGte() string
+	SetCreatedAtGte(createdAtGte string) TaxRateQueryInterface
+
+	HasCreatedAtLte() bool
+	CreatedAtLte() string
+	SetCreatedAtLte(createdAtLte string) TaxRateQueryInterface
+
+	HasID() bool
+	ID() string
+	SetID(id string) TaxRateQueryInterface
+
+	HasIDIn() bool
+	IDIn() []string
+	SetIDIn(idIn []string) TaxRateQueryInterface
+
+	HasLimit() bool
+	Limit() int
+	SetLimit(limit int) TaxRateQueryInterface
+
+	HasOffset() bool
+	Offset() int
+	SetOffset(offset int) TaxRateQueryInterface
+
+	HasOrderBy() bool
+	OrderBy() string
+	SetOrderBy(orderBy string) TaxRateQueryInterface
+
+	HasRegion() bool
+	Region() string
+	SetRegion(region string) TaxRateQueryInterface
+
+	HasSortDirection() bool
+	SortDirection() string
+	SetSortDirection(sortDirection string) TaxRateQueryInterface
+
+	HasSoftDeletedIncluded() bool
+	SoftDeletedIncluded() bool
+	SetSoftDeletedIncluded(softDeletedIncluded bool) TaxRateQueryInterface
+
+	HasStatus() bool
+	Status() string
+	SetStatus(status string) TaxRateQueryInterface
+
+	HasStatusIn() bool
+	StatusIn() []string
+	SetStatusIn(statusIn []string) TaxRateQueryInterface
+
+	hasProperty(name string) bool
+}
+
+func NewTaxRateQuery() TaxRateQueryInterface {
+	return &taxRateQueryImplementation{
+		properties: make(map[string]any),
+	}
+}
+
+type taxRateQueryImplementation struct {
+	properties map[string]any
+}
+
+func (c *taxRateQueryImplementation) Validate() error {
+
+	if c.HasCategoryID() && c.CategoryID() == "" {
+		return errors.New("tax rate query. category_id cannot be empty")
+	}
+
+	if c.HasCreatedAtGte() && c.CreatedAtGte() == "" {
+		return errors.New("tax rate query. created_at_gte cannot be empty")
+	}
+
+	if c.HasCreatedAtLte() && c.CreatedAtLte() == "" {
+		return errors.New("tax rate query. created_at_lte cannot be empty")
+	}
+
+	if c.HasID() && c.ID() == "" {
+		return errors.New("tax rate query. id cannot be empty")
+	}
+
+	if c.HasIDIn() && len(c.IDIn()) == 0 {
+		return errors.New("tax rate query. id_in cannot be empty")
+	}
+
+	if c.HasRegion() && c.Region() == "" {
+		return errors.New("tax rate query. region cannot be empty")
+	}
+
+	if c.HasSortDirection() && c.SortDirection() == "" {
+		return errors.New("tax rate query. sort_direction cannot be empty")
+	}
+
+	if c.HasLimit() && c.Limit() <= 0 {
+		return errors.New("tax rate query. limit must be greater than 0")
+	}
+
+	if c.HasOffset() && c.Offset() < 0 {
+		return errors.New("tax rate query. offset must be greater than or equal to 0")
+	}
+
+	if c.HasOrderBy() && c.OrderBy() == "" {
+		return errors.New("tax rate query. order_by cannot be empty")
+	}
+
+	if c.HasStatus() && c.Status() == "" {
+		return errors.New("tax rate query. status cannot be empty")
+	}
+
+	if c.HasStatusIn() && len(c.StatusIn()) == 0 {
+		return errors.New("tax rate query. status_in cannot be empty")
+	}
+
+	return nil
+}
+
+func (c *taxRateQueryImplementation) Columns() []string {
+	if !c.hasProperty("columns") {
+		return []string{}
+	}
+
+	return c.properties["columns"].([]string)
+}
+
+func (c *taxRateQueryImplementation) SetColumns(columns []string) TaxRateQueryInterface {
+	c.properties["columns"] = columns
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasCountOnly() bool {
+	return c.hasProperty("count_only")
+}
+
+func (c *taxRateQueryImplementation) IsCountOnly() bool {
+	if !c.HasCountOnly() {
+		return false
+	}
+
+	return c.properties["count_only"].(bool)
+}
+
+func (c *taxRateQueryImplementation) SetCountOnly(countOnly bool) TaxRateQueryInterface {
+	c.properties["count_only"] = countOnly
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasCategoryID() bool {
+	return c.hasProperty("category_id")
+}
+
+func (c *taxRateQueryImplementation) CategoryID() string {
+	if !c.HasCategoryID() {
+		return ""
+	}
+
+	return c.properties["category_id"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetCategoryID(categoryID string) TaxRateQueryInterface {
+	c.properties["category_id"] = categoryID
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasCreatedAtGte() bool {
+	return c.hasProperty("created_at_gte")
+}
+
+func (c *taxRateQueryImplementation) CreatedAtGte() string {
+	if !c.HasCreatedAtGte() {
+		return ""
+	}
+
+	return c.properties["created_at_gte"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetCreatedAtGte(createdAtGte string) TaxRateQueryInterface {
+	c.properties["created_at_gte"] = createdAtGte
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasCreatedAtLte() bool {
+	return c.hasProperty("created_at_lte")
+}
+
+func (c *taxRateQueryImplementation) CreatedAtLte() string {
+	if !c.HasCreatedAtLte() {
+		return ""
+	}
+
+	return c.properties["created_at_lte"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetCreatedAtLte(createdAtLte string) TaxRateQueryInterface {
+	c.properties["created_at_lte"] = createdAtLte
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasID() bool {
+	return c.hasProperty("id")
+}
+
+func (c *taxRateQueryImplementation) ID() string {
+	if !c.HasID() {
+		return ""
+	}
+
+	return c.properties["id"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetID(id string) TaxRateQueryInterface {
+	c.properties["id"] = id
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasIDIn() bool {
+	return c.hasProperty("id_in")
+}
+
+func (c *taxRateQueryImplementation) IDIn() []string {
+	if !c.HasIDIn() {
+		return []string{}
+	}
+
+	return c.properties["id_in"].([]string)
+}
+
+func (c *taxRateQueryImplementation) SetIDIn(idIn []string) TaxRateQueryInterface {
+	c.properties["id_in"] = idIn
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasLimit() bool {
+	return c.hasProperty("limit")
+}
+
+func (c *taxRateQueryImplementation) Limit() int {
+	if !c.HasLimit() {
+		return 0
+	}
+
+	return c.properties["limit"].(int)
+}
+
+func (c *taxRateQueryImplementation) SetLimit(limit int) TaxRateQueryInterface {
+	c.properties["limit"] = limit
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasOffset() bool {
+	return c.hasProperty("offset")
+}
+
+func (c *taxRateQueryImplementation) Offset() int {
+	if !c.HasOffset() {
+		return 0
+	}
+
+	return c.properties["offset"].(int)
+}
+
+func (c *taxRateQueryImplementation) SetOffset(offset int) TaxRateQueryInterface {
+	c.properties["offset"] = offset
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasOrderBy() bool {
+	return c.hasProperty("order_by")
+}
+
+func (c *taxRateQueryImplementation) OrderBy() string {
+	if !c.HasOrderBy() {
+		return ""
+	}
+
+	return c.properties["order_by"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetOrderBy(orderBy string) TaxRateQueryInterface {
+	c.properties["order_by"] = orderBy
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasRegion() bool {
+	return c.hasProperty("region")
+}
+
+func (c *taxRateQueryImplementation) Region() string {
+	if !c.HasRegion() {
+		return ""
+	}
+
+	return c.properties["region"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetRegion(region string) TaxRateQueryInterface {
+	c.properties["region"] = region
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasSortDirection() bool {
+	return c.hasProperty("sort_direction")
+}
+
+func (c *taxRateQueryImplementation) SortDirection() string {
+	if !c.HasSortDirection() {
+		return ""
+	}
+
+	return c.properties["sort_direction"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetSortDirection(sortDirection string) TaxRateQueryInterface {
+	c.properties["sort_direction"] = sortDirection
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasSoftDeletedIncluded() bool {
+	return c.hasProperty("soft_deleted_included")
+}
+
+func (c *taxRateQueryImplementation) SoftDeletedIncluded() bool {
+	if !c.HasSoftDeletedIncluded() {
+		return false
+	}
+
+	return c.properties["soft_deleted_included"].(bool)
+}
+
+func (c *taxRateQueryImplementation) SetSoftDeletedIncluded(softDeletedIncluded bool) TaxRateQueryInterface {
+	c.properties["soft_deleted_included"] = softDeletedIncluded
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasStatus() bool {
+	return c.hasProperty("status")
+}
+
+func (c *taxRateQueryImplementation) Status() string {
+	if !c.HasStatus() {
+		return ""
+	}
+
+	return c.properties["status"].(string)
+}
+
+func (c *taxRateQueryImplementation) SetStatus(status string) TaxRateQueryInterface {
+	c.properties["status"] = status
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) HasStatusIn() bool {
+	return c.hasProperty("status_in")
+}
+
+func (c *taxRateQueryImplementation) StatusIn() []string {
+	if !c.HasStatusIn() {
+		return []string{}
+	}
+
+	return c.properties["status_in"].([]string)
+}
+
+func (c *taxRateQueryImplementation) SetStatusIn(statusIn []string) TaxRateQueryInterface {
+	c.properties["status_in"] = statusIn
+
+	return c
+}
+
+func (c *taxRateQueryImplementation) hasProperty(name string) bool {
+	_, ok := c.properties[name]
+	return ok
+}
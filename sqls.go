@@ -1,6 +1,11 @@
 package shopstore
 
-import "github.com/gouniverse/sb"
+import (
+	"strings"
+
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+)
 
 // sqlCategoryTableCreate returns a SQL string for creating the category table
 func (st *Store) sqlCategoryTableCreate() string {
@@ -27,6 +32,11 @@ func (st *Store) sqlCategoryTableCreate() string {
 			Type:   sb.COLUMN_TYPE_STRING,
 			Length: 255,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_SLUG,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_DESCRIPTION,
 			Type: sb.COLUMN_TYPE_TEXT,
@@ -91,11 +101,33 @@ func (st *Store) sqlDiscountTableCreate() string {
 			Length:   10,
 			Decimals: 2,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
 		Column(sb.Column{
 			Name:   COLUMN_CODE,
 			Type:   sb.COLUMN_TYPE_STRING,
 			Length: 40,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_DURATION,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_DURATION_IN_MONTHS,
+			Type: sb.COLUMN_TYPE_INTEGER,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MAX_USES,
+			Type: sb.COLUMN_TYPE_INTEGER,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MAX_USES_PER_CUSTOMER,
+			Type: sb.COLUMN_TYPE_INTEGER,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_STARTS_AT,
 			Type: sb.COLUMN_TYPE_DATETIME,
@@ -220,6 +252,11 @@ func (store *Store) sqlOrderLineItemTableCreate() string {
 			Type:   sb.COLUMN_TYPE_STRING,
 			Length: 40,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_VARIANT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
 		Column(sb.Column{
 			Name:   COLUMN_TITLE,
 			Type:   sb.COLUMN_TYPE_STRING,
@@ -236,6 +273,11 @@ func (store *Store) sqlOrderLineItemTableCreate() string {
 			Length:   10,
 			Decimals: 2,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_METAS,
 			Type: sb.COLUMN_TYPE_TEXT,
@@ -291,6 +333,49 @@ func (store *Store) sqlOrderTableCreate() string {
 			Length:   10,
 			Decimals: 2,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_SUBTOTAL,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_DISCOUNT_TOTAL,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_TAX_TOTAL,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_SHIPPING_TOTAL,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_GRAND_TOTAL,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_BILLING_ADDRESS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SHIPPING_ADDRESS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_METAS,
 			Type: sb.COLUMN_TYPE_TEXT,
@@ -335,6 +420,24 @@ func (store *Store) sqlProductTableCreate() string {
 			Type:   sb.COLUMN_TYPE_STRING,
 			Length: 255,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_SLUG,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		// Empty SKUs and barcodes are stored as NULL, see productRecord
+		Column(sb.Column{
+			Name:     COLUMN_SKU,
+			Type:     sb.COLUMN_TYPE_STRING,
+			Length:   100,
+			Nullable: true,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_BARCODE,
+			Type:     sb.COLUMN_TYPE_STRING,
+			Length:   50,
+			Nullable: true,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_DESCRIPTION,
 			Type: sb.COLUMN_TYPE_TEXT,
@@ -354,6 +457,17 @@ func (store *Store) sqlProductTableCreate() string {
 			Length:   10,
 			Decimals: 2,
 		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_WEIGHT,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: WEIGHT_DECIMALS,
+		}).
 		Column(sb.Column{
 			Name: COLUMN_METAS,
 			Type: sb.COLUMN_TYPE_TEXT,
@@ -378,3 +492,1162 @@ func (store *Store) sqlProductTableCreate() string {
 
 	return sql
 }
+
+// sqlProductCategoryTableCreate returns a SQL string for creating the product to category pivot table
+func (store *Store) sqlProductCategoryTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productCategoryTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CATEGORY_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlOrderStatusHistoryTableCreate returns a SQL string for creating the order status history table
+func (store *Store) sqlOrderStatusHistoryTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.orderStatusHistoryTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_FROM_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TO_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ACTOR,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_NOTE,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlDiscountRedemptionTableCreate returns a SQL string for creating the discount redemption table
+func (store *Store) sqlDiscountRedemptionTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.discountRedemptionTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_DISCOUNT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CUSTOMER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlProductPriceTableCreate returns a SQL string for creating the product price table,
+// holding the price of a product in each currency
+func (store *Store) sqlProductPriceTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productPriceTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_PRICE,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlTaxRateTableCreate returns a SQL string for creating the tax rate table
+func (store *Store) sqlTaxRateTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.taxRateTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_REGION,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CATEGORY_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_RATE,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: TAX_RATE_DECIMALS,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_INCLUSIVE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlShippingMethodTableCreate returns a SQL string for creating the shipping method table
+func (store *Store) sqlShippingMethodTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.shippingMethodTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TYPE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_DESCRIPTION,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_PRICE,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_RATE_BANDS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_FREE_SHIPPING_THRESHOLD,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlShipmentTableCreate returns a SQL string for creating the shipment table
+func (store *Store) sqlShipmentTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.shipmentTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CARRIER,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TRACKING_NUMBER,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SHIPPED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlShipmentLineTableCreate returns a SQL string for creating the shipment line table,
+// holding the quantity of each order line item sent in a shipment
+func (store *Store) sqlShipmentLineTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.shipmentLineTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_SHIPMENT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_LINE_ITEM_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_QUANTITY,
+			Type:   sb.COLUMN_TYPE_INTEGER,
+			Length: 10,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlRefundTableCreate returns a SQL string for creating the refund table
+func (store *Store) sqlRefundTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.refundTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_LINE_ITEM_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_AMOUNT,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_REASON,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlPaymentTableCreate returns a SQL string for creating the payment table
+func (store *Store) sqlPaymentTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.paymentTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PROVIDER,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 50,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PROVIDER_REFERENCE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_AMOUNT,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlCustomerTableCreate returns a SQL string for creating the customer table
+func (store *Store) sqlCustomerTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.customerTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_EMAIL,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_FIRST_NAME,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_LAST_NAME,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PHONE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 50,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlAddressTableCreate returns a SQL string for creating the address table,
+// the address book of the customers
+func (store *Store) sqlAddressTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.addressTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CUSTOMER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TYPE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_FIRST_NAME,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_LAST_NAME,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_COMPANY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ADDRESS_LINE_1,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ADDRESS_LINE_2,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CITY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_REGION,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_POSTCODE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_COUNTRY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 2,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PHONE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 50,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlCartTableCreate returns a SQL string for creating the cart table
+func (store *Store) sqlCartTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.cartTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_SESSION_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CUSTOMER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_EXPIRES_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlCartItemTableCreate returns a SQL string for creating the cart item table
+func (store *Store) sqlCartItemTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.cartItemTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CART_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_QUANTITY,
+			Type:   sb.COLUMN_TYPE_INTEGER,
+			Length: 10,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_PRICE,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   10,
+			Decimals: 2,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlStockReservationTableCreate returns a SQL string for creating the stock reservation table
+func (store *Store) sqlStockReservationTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.stockReservationTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_VARIANT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ORDER_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_QUANTITY,
+			Type:   sb.COLUMN_TYPE_INTEGER,
+			Length: 10,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_EXPIRES_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlProductOptionTableCreate returns a SQL string for creating the product option table
+func (store *Store) sqlProductOptionTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productOptionTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SEQUENCE,
+			Type: sb.COLUMN_TYPE_INTEGER,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlProductOptionValueTableCreate returns a SQL string for creating the product option value table
+func (store *Store) sqlProductOptionValueTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productOptionValueTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_OPTION_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SEQUENCE,
+			Type: sb.COLUMN_TYPE_INTEGER,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlProductVariantTableCreate returns a SQL string for creating the product variant table
+func (store *Store) sqlProductVariantTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productVariantTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_STATUS,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_SKU,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_TITLE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		// Empty if the variant has the price of the product
+		Column(sb.Column{
+			Name:   COLUMN_PRICE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_CURRENCY,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 3,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_QUANTITY,
+			Type:   sb.COLUMN_TYPE_INTEGER,
+			Length: 10,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_ATTRIBUTES,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_METAS,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_MEMO,
+			Type: sb.COLUMN_TYPE_TEXT,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_SOFT_DELETED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlSlugRedirectTableCreate returns a SQL string for creating the slug redirect table
+func (store *Store) sqlSlugRedirectTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.slugRedirectTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ENTITY_TYPE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 20,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_ENTITY_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_SLUG,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// sqlProductAttributeTableCreate returns a SQL string for creating the product attribute table
+func (store *Store) sqlProductAttributeTableCreate() string {
+	sql := sb.NewBuilder(sb.DatabaseDriverName(store.db)).
+		Table(store.productAttributeTableName).
+		Column(sb.Column{
+			Name:       COLUMN_ID,
+			Type:       sb.COLUMN_TYPE_STRING,
+			Length:     40,
+			PrimaryKey: true,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_PRODUCT_ID,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 40,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_NAME,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 100,
+		}).
+		Column(sb.Column{
+			Name:   COLUMN_VALUE,
+			Type:   sb.COLUMN_TYPE_STRING,
+			Length: 255,
+		}).
+		Column(sb.Column{
+			Name:     COLUMN_VALUE_NUMERIC,
+			Type:     sb.COLUMN_TYPE_DECIMAL,
+			Length:   20,
+			Decimals: 6,
+			Nullable: true,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_CREATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		Column(sb.Column{
+			Name: COLUMN_UPDATED_AT,
+			Type: sb.COLUMN_TYPE_DATETIME,
+		}).
+		CreateIfNotExists()
+
+	return sql
+}
+
+// tableColumnAdded is a column added to a table after the table was first
+// created, with the value of the column for the rows stored before
+type tableColumnAdded struct {
+	tableName string
+	column    sb.Column
+	value     any
+}
+
+// tableColumnsAdded returns the columns added to the tables after they were
+// first created, for AutoMigrate to add to the tables created before. Columns
+// added to a table go at the end of the list, with the definition used in the
+// table create SQL.
+func (store *Store) tableColumnsAdded() []tableColumnAdded {
+	return []tableColumnAdded{
+		// Discount usage limits and duration
+		{store.discountTableName, sb.Column{Name: COLUMN_MAX_USES, Type: sb.COLUMN_TYPE_INTEGER}, 0},
+		{store.discountTableName, sb.Column{Name: COLUMN_MAX_USES_PER_CUSTOMER, Type: sb.COLUMN_TYPE_INTEGER}, 0},
+		{store.discountTableName, sb.Column{Name: COLUMN_DURATION, Type: sb.COLUMN_TYPE_STRING, Length: 20}, DISCOUNT_DURATION_FOREVER},
+		{store.discountTableName, sb.Column{Name: COLUMN_DURATION_IN_MONTHS, Type: sb.COLUMN_TYPE_INTEGER}, 0},
+
+		// Currencies
+		{store.discountTableName, sb.Column{Name: COLUMN_CURRENCY, Type: sb.COLUMN_TYPE_STRING, Length: 3}, MONEY_CURRENCY_DEFAULT},
+		{store.orderTableName, sb.Column{Name: COLUMN_CURRENCY, Type: sb.COLUMN_TYPE_STRING, Length: 3}, MONEY_CURRENCY_DEFAULT},
+		{store.orderLineItemTableName, sb.Column{Name: COLUMN_CURRENCY, Type: sb.COLUMN_TYPE_STRING, Length: 3}, MONEY_CURRENCY_DEFAULT},
+		{store.productTableName, sb.Column{Name: COLUMN_CURRENCY, Type: sb.COLUMN_TYPE_STRING, Length: 3}, MONEY_CURRENCY_DEFAULT},
+
+		// Order totals, orders without a grand total are to pay their price
+		{store.orderTableName, sb.Column{Name: COLUMN_SUBTOTAL, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: 2}, "0.00"},
+		{store.orderTableName, sb.Column{Name: COLUMN_DISCOUNT_TOTAL, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: 2}, "0.00"},
+		{store.orderTableName, sb.Column{Name: COLUMN_TAX_TOTAL, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: 2}, "0.00"},
+		{store.orderTableName, sb.Column{Name: COLUMN_SHIPPING_TOTAL, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: 2}, "0.00"},
+		{store.orderTableName, sb.Column{Name: COLUMN_GRAND_TOTAL, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: 2}, "0.00"},
+
+		// Product weight
+		{store.productTableName, sb.Column{Name: COLUMN_WEIGHT, Type: sb.COLUMN_TYPE_DECIMAL, Length: 10, Decimals: WEIGHT_DECIMALS}, "0.000"},
+
+		// Order address snapshots
+		{store.orderTableName, sb.Column{Name: COLUMN_BILLING_ADDRESS, Type: sb.COLUMN_TYPE_TEXT}, ""},
+		{store.orderTableName, sb.Column{Name: COLUMN_SHIPPING_ADDRESS, Type: sb.COLUMN_TYPE_TEXT}, ""},
+
+		// Line item variants
+		{store.orderLineItemTableName, sb.Column{Name: COLUMN_VARIANT_ID, Type: sb.COLUMN_TYPE_STRING, Length: 40}, ""},
+
+		// Product SKUs and barcodes, NULL if none (see productRecord)
+		{store.productTableName, sb.Column{Name: COLUMN_SKU, Type: sb.COLUMN_TYPE_STRING, Length: 100}, nil},
+		{store.productTableName, sb.Column{Name: COLUMN_BARCODE, Type: sb.COLUMN_TYPE_STRING, Length: 50}, nil},
+
+		// Slugs, set from the titles by AutoMigrate (see slugsMigrate)
+		{store.categoryTableName, sb.Column{Name: COLUMN_SLUG, Type: sb.COLUMN_TYPE_STRING, Length: 255}, nil},
+		{store.productTableName, sb.Column{Name: COLUMN_SLUG, Type: sb.COLUMN_TYPE_STRING, Length: 255}, nil},
+	}
+}
+
+// tableIndex is an index of a table
+type tableIndex struct {
+	tableName string
+	columns   []string
+	unique    bool
+}
+
+// name returns the name of the index, made of the table and column names
+func (index tableIndex) name() string {
+	return "idx_" + index.tableName + "_" + strings.Join(index.columns, "_")
+}
+
+// tableIndexes returns the indexes of the tables. Unique constraints are
+// unique indexes, so they can be added to the tables created before too.
+func (store *Store) tableIndexes() []tableIndex {
+	return []tableIndex{
+		{store.categoryTableName, []string{COLUMN_SLUG}, true},
+		{store.productCategoryTableName, []string{COLUMN_PRODUCT_ID, COLUMN_CATEGORY_ID}, true},
+		{store.productPriceTableName, []string{COLUMN_PRODUCT_ID, COLUMN_CURRENCY}, true},
+		{store.productAttributeTableName, []string{COLUMN_PRODUCT_ID}, false},
+		{store.productAttributeTableName, []string{COLUMN_NAME, COLUMN_VALUE}, false},
+		{store.productTableName, []string{COLUMN_SKU}, true},
+		{store.productTableName, []string{COLUMN_BARCODE}, true},
+		{store.productTableName, []string{COLUMN_SLUG}, true},
+	}
+}
+
+// sqlIndexCreate returns a SQL string for creating the index if it does
+// not exist. MySQL and MSSQL have no CREATE INDEX IF NOT EXISTS, AutoMigrate
+// checks if the index exists first instead (see indexExists).
+func (store *Store) sqlIndexCreate(index tableIndex) string {
+	sql := sb.NewBuilder(store.dbDriverName).
+		Table(index.tableName).
+		CreateIndex(index.name(), index.columns...)
+
+	create := lo.Ternary(index.unique, "CREATE UNIQUE INDEX ", "CREATE INDEX ")
+
+	if !lo.Contains([]string{sb.DIALECT_MYSQL, sb.DIALECT_MSSQL}, store.dbDriverName) {
+		create += "IF NOT EXISTS "
+	}
+
+	sql = strings.Replace(sql, "CREATE INDEX ", create, 1)
+
+	// MSSQL allows a single NULL in a unique index, unlike the other
+	// databases. Leaving the NULLs out of the index lets any number of
+	// rows have none, e.g. the products without SKU (see productRecord).
+	if index.unique && store.dbDriverName == sb.DIALECT_MSSQL {
+		notNull := lo.Map(index.columns, func(column string, _ int) string {
+			return column + " IS NOT NULL"
+		})
+
+		sql = strings.TrimSuffix(sql, ";") + " WHERE " + strings.Join(notNull, " AND ") + ";"
+	}
+
+	return sql
+}
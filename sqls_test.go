@@ -0,0 +1,37 @@
+package shopstore
+
+import (
+	"testing"
+
+	"github.com/gouniverse/sb"
+)
+
+func TestSqlIndexCreate(t *testing.T) {
+	index := tableIndex{"shop_product", []string{COLUMN_SKU}, true}
+
+	expected := map[string]string{
+		sb.DIALECT_MSSQL:    `CREATE UNIQUE INDEX idx_shop_product_sku ON shop_product (sku) WHERE sku IS NOT NULL;`,
+		sb.DIALECT_MYSQL:    "CREATE UNIQUE INDEX `idx_shop_product_sku` ON `shop_product` (`sku`);",
+		sb.DIALECT_POSTGRES: `CREATE UNIQUE INDEX IF NOT EXISTS "idx_shop_product_sku" ON "shop_product" ("sku");`,
+		sb.DIALECT_SQLITE:   `CREATE UNIQUE INDEX IF NOT EXISTS "idx_shop_product_sku" ON "shop_product" ("sku");`,
+	}
+
+	for dialect, sql := range expected {
+		store := &Store{dbDriverName: dialect}
+
+		if found := store.sqlIndexCreate(index); found != sql {
+			t.Fatal("Index SQL for "+dialect+" MUST be", sql, "found:", found)
+		}
+	}
+
+	// Only unique indexes leave the NULLs out on MSSQL
+	index = tableIndex{"shop_product_attribute", []string{COLUMN_NAME, COLUMN_VALUE}, false}
+
+	store := &Store{dbDriverName: sb.DIALECT_MSSQL}
+
+	sql := `CREATE INDEX idx_shop_product_attribute_name_value ON shop_product_attribute (name,value);`
+
+	if found := store.sqlIndexCreate(index); found != sql {
+		t.Fatal("Index SQL MUST be", sql, "found:", found)
+	}
+}
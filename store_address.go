@@ -0,0 +1,286 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) AddressCount(ctx context.Context, options AddressQueryInterface) (int64, error) {
+	q, _, err := store.addressQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) AddressCreate(ctx context.Context, address AddressInterface) error {
+	address.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	address.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	address.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := address.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.addressTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	address.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) AddressDelete(ctx context.Context, address AddressInterface) error {
+	if address == nil {
+		return errors.New("address is nil")
+	}
+
+	return store.AddressDeleteByID(ctx, address.ID())
+}
+
+func (store *Store) AddressDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("address id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.addressTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) AddressFindByID(ctx context.Context, id string) (AddressInterface, error) {
+	if id == "" {
+		return nil, errors.New("address id is empty")
+	}
+
+	list, err := store.AddressList(ctx, NewAddressQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) AddressList(ctx context.Context, options AddressQueryInterface) ([]AddressInterface, error) {
+	q, columns, err := store.addressQuery(options)
+
+	if err != nil {
+		return []AddressInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []AddressInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []AddressInterface{}, err
+	}
+
+	list := []AddressInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewAddressFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) AddressSoftDelete(ctx context.Context, address AddressInterface) error {
+	if address == nil {
+		return errors.New("address is nil")
+	}
+
+	address.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.AddressUpdate(ctx, address)
+}
+
+func (store *Store) AddressSoftDeleteByID(ctx context.Context, id string) error {
+	address, err := store.AddressFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.AddressSoftDelete(ctx, address)
+}
+
+func (store *Store) AddressUpdate(ctx context.Context, address AddressInterface) error {
+	if address == nil {
+		return errors.New("address is nil")
+	}
+
+	address.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := address.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.addressTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(address.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	address.MarkAsNotDirty()
+
+	return err
+}
+
+func (store *Store) addressQuery(options AddressQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewAddressQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.addressTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasCustomerID() {
+		q = q.Where(goqu.C(COLUMN_CUSTOMER_ID).Eq(options.CustomerID()))
+	}
+
+	if options.HasType() {
+		q = q.Where(goqu.C(COLUMN_TYPE).Eq(options.Type()))
+	}
+
+	if options.HasCountry() {
+		q = q.Where(goqu.C(COLUMN_COUNTRY).Eq(options.Country()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted addresses requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
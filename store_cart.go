@@ -0,0 +1,294 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) CartCount(ctx context.Context, options CartQueryInterface) (int64, error) {
+	q, _, err := store.cartQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) CartCreate(ctx context.Context, cart CartInterface) error {
+	cart.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	cart.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	cart.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := cart.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.cartTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	cart.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) CartDelete(ctx context.Context, cart CartInterface) error {
+	if cart == nil {
+		return errors.New("cart is nil")
+	}
+
+	return store.CartDeleteByID(ctx, cart.ID())
+}
+
+func (store *Store) CartDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("cart id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.cartTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) CartFindByID(ctx context.Context, id string) (CartInterface, error) {
+	if id == "" {
+		return nil, errors.New("cart id is empty")
+	}
+
+	list, err := store.CartList(ctx, NewCartQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) CartList(ctx context.Context, options CartQueryInterface) ([]CartInterface, error) {
+	q, columns, err := store.cartQuery(options)
+
+	if err != nil {
+		return []CartInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []CartInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []CartInterface{}, err
+	}
+
+	list := []CartInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewCartFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) CartSoftDelete(ctx context.Context, cart CartInterface) error {
+	if cart == nil {
+		return errors.New("cart is nil")
+	}
+
+	cart.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.CartUpdate(ctx, cart)
+}
+
+func (store *Store) CartSoftDeleteByID(ctx context.Context, id string) error {
+	cart, err := store.CartFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.CartSoftDelete(ctx, cart)
+}
+
+func (store *Store) CartUpdate(ctx context.Context, cart CartInterface) error {
+	if cart == nil {
+		return errors.New("cart is nil")
+	}
+
+	cart.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := cart.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.cartTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(cart.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	cart.MarkAsNotDirty()
+
+	return err
+}
+
+func (store *Store) cartQuery(options CartQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewCartQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.cartTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if options.HasStatusIn() {
+		q = q.Where(goqu.C(COLUMN_STATUS).In(options.StatusIn()))
+	}
+
+	if options.HasCustomerID() {
+		q = q.Where(goqu.C(COLUMN_CUSTOMER_ID).Eq(options.CustomerID()))
+	}
+
+	if options.HasSessionID() {
+		q = q.Where(goqu.C(COLUMN_SESSION_ID).Eq(options.SessionID()))
+	}
+
+	if options.HasExpiresAtLte() {
+		q = q.Where(goqu.C(COLUMN_EXPIRES_AT).Lte(options.ExpiresAtLte()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted carts requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
@@ -0,0 +1,97 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gouniverse/base/database"
+)
+
+// CartConvertToOrder places an order for the items in the cart (see PlaceOrder)
+// and marks the cart as converted. The order is for the customer owning the
+// cart, in the currency of the cart, at the prices copied to the cart items.
+//
+// Only active, not expired carts with items can be converted.
+func (store *Store) CartConvertToOrder(ctx context.Context, cartID string) (OrderInterface, []OrderLineItemInterface, error) {
+	if cartID == "" {
+		return nil, nil, errors.New("cart id is empty")
+	}
+
+	var order OrderInterface
+	var lineItems []OrderLineItemInterface
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		cart, err := store.CartFindByID(txCtx, cartID)
+
+		if err != nil {
+			return err
+		}
+
+		if cart == nil {
+			return errors.New("cart not found")
+		}
+
+		if !cart.IsActive() || cart.IsExpired() {
+			return errors.New("cart " + cart.ID() + " is no longer active")
+		}
+
+		cartItems, err := store.CartItemList(txCtx, NewCartItemQuery().SetCartID(cart.ID()))
+
+		if err != nil {
+			return err
+		}
+
+		if len(cartItems) < 1 {
+			return errors.New("cart is empty")
+		}
+
+		order = NewOrder().
+			SetCustomerID(cart.CustomerID()).
+			SetCurrency(cart.Currency())
+
+		lineItems = []OrderLineItemInterface{}
+
+		for _, cartItem := range cartItems {
+			lineItems = append(lineItems, NewOrderLineItem().
+				SetProductID(cartItem.ProductID()).
+				SetTitle(cartItem.Title()).
+				SetQuantityInt(cartItem.QuantityInt()).
+				SetPriceMoney(cartItem.PriceMoney()))
+		}
+
+		if err := store.PlaceOrder(txCtx, order, lineItems); err != nil {
+			return err
+		}
+
+		cart.SetStatus(CART_STATUS_CONVERTED)
+		cart.SetOrderID(order.ID())
+
+		return store.CartUpdate(txCtx, cart)
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return order, lineItems, nil
+}
+
+// productPriceIn returns the price of the product in the currency,
+// the price set for the currency or else the price of the product itself
+func (store *Store) productPriceIn(ctx context.Context, product ProductInterface, currency string) (Money, error) {
+	productPrice, err := store.ProductPriceGet(ctx, product.ID(), currency)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	if productPrice != nil {
+		return productPrice.PriceMoney(), nil
+	}
+
+	if product.Currency() != currency {
+		return Money{}, errors.New("product " + product.ID() + " has no price in " + currency)
+	}
+
+	return product.PriceMoney(), nil
+}
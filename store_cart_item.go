@@ -0,0 +1,347 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+// CartItemAdd puts the quantity of the product in the cart, taking a copy of
+// the price of the product in the cart currency. If the product is already
+// in the cart, its quantity is increased and the price copied before is kept.
+func (store *Store) CartItemAdd(ctx context.Context, cart CartInterface, product ProductInterface, quantity int64) (CartItemInterface, error) {
+	if cart == nil {
+		return nil, errors.New("cart is nil")
+	}
+
+	if product == nil {
+		return nil, errors.New("product is nil")
+	}
+
+	if quantity < 1 {
+		return nil, errors.New("cart item quantity must be greater than 0")
+	}
+
+	if !cart.IsActive() || cart.IsExpired() {
+		return nil, errors.New("cart " + cart.ID() + " is no longer active")
+	}
+
+	if !product.IsActive() {
+		return nil, errors.New("product " + product.ID() + " is not active")
+	}
+
+	var cartItem CartItemInterface
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		existing, err := store.CartItemList(txCtx, NewCartItemQuery().
+			SetCartID(cart.ID()).
+			SetProductID(product.ID()).
+			SetLimit(1))
+
+		if err != nil {
+			return err
+		}
+
+		if len(existing) > 0 {
+			cartItem = existing[0]
+			cartItem.SetQuantityInt(cartItem.QuantityInt() + quantity)
+			return store.CartItemUpdate(txCtx, cartItem)
+		}
+
+		price, err := store.productPriceIn(txCtx, product, cart.Currency())
+
+		if err != nil {
+			return err
+		}
+
+		cartItem = NewCartItem().
+			SetCartID(cart.ID()).
+			SetProductID(product.ID()).
+			SetTitle(product.Title()).
+			SetQuantityInt(quantity).
+			SetPriceMoney(price)
+
+		return store.CartItemCreate(txCtx, cartItem)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cartItem, nil
+}
+
+func (store *Store) CartItemCount(ctx context.Context, options CartItemQueryInterface) (int64, error) {
+	q, _, err := store.cartItemQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) CartItemCreate(ctx context.Context, cartItem CartItemInterface) error {
+	cartItem.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	cartItem.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	cartItem.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := cartItem.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.cartItemTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	cartItem.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) CartItemDelete(ctx context.Context, cartItem CartItemInterface) error {
+	if cartItem == nil {
+		return errors.New("cart item is nil")
+	}
+
+	return store.CartItemDeleteByID(ctx, cartItem.ID())
+}
+
+func (store *Store) CartItemDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("cart item id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.cartItemTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) CartItemFindByID(ctx context.Context, id string) (CartItemInterface, error) {
+	if id == "" {
+		return nil, errors.New("cart item id is empty")
+	}
+
+	list, err := store.CartItemList(ctx, NewCartItemQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) CartItemList(ctx context.Context, options CartItemQueryInterface) ([]CartItemInterface, error) {
+	q, columns, err := store.cartItemQuery(options)
+
+	if err != nil {
+		return []CartItemInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []CartItemInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []CartItemInterface{}, err
+	}
+
+	list := []CartItemInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewCartItemFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) CartItemSoftDelete(ctx context.Context, cartItem CartItemInterface) error {
+	if cartItem == nil {
+		return errors.New("cart item is nil")
+	}
+
+	cartItem.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.CartItemUpdate(ctx, cartItem)
+}
+
+func (store *Store) CartItemSoftDeleteByID(ctx context.Context, id string) error {
+	cartItem, err := store.CartItemFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.CartItemSoftDelete(ctx, cartItem)
+}
+
+func (store *Store) CartItemUpdate(ctx context.Context, cartItem CartItemInterface) error {
+	if cartItem == nil {
+		return errors.New("cart item is nil")
+	}
+
+	cartItem.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := cartItem.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.cartItemTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(cartItem.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	cartItem.MarkAsNotDirty()
+
+	return err
+}
+
+func (store *Store) cartItemQuery(options CartItemQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewCartItemQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.cartItemTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasCartID() {
+		q = q.Where(goqu.C(COLUMN_CART_ID).Eq(options.CartID()))
+	}
+
+	if options.HasProductID() {
+		q = q.Where(goqu.C(COLUMN_PRODUCT_ID).Eq(options.ProductID()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted cart items requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
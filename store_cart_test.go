@@ -0,0 +1,148 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func TestStoreCartConvertToOrder(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Book").
+		SetPriceMoney(NewMoney(1250, "USD")).
+		SetQuantityInt(10)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	cart := NewCart().
+		SetSessionID("SESSION01").
+		SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.CartCreate(ctx, cart); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.CartItemAdd(ctx, cart, product, 1); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The price in the cart does not change with the product
+	product.SetPriceMoney(NewMoney(1500, "USD"))
+
+	if err := store.ProductUpdate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	cartItem, err := store.CartItemAdd(ctx, cart, product, 2)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if cartItem.QuantityInt() != 3 || cartItem.PriceMoney().Amount() != 1250 {
+		t.Fatal("Cart item MUST be 3 at 12.50, found:", cartItem.Quantity(), cartItem.Price())
+	}
+
+	order, lineItems, err := store.CartConvertToOrder(ctx, cart.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if order.CustomerID() != "CUSTOMER01_ID" {
+		t.Fatal("Order customer MUST be CUSTOMER01_ID, found:", order.CustomerID())
+	}
+
+	if len(lineItems) != 1 || lineItems[0].QuantityInt() != 3 || lineItems[0].Title() != "Book" {
+		t.Fatal("Order MUST have 1 line item of 3 books, found:", len(lineItems))
+	}
+
+	if order.SubtotalMoney().Amount() != 3750 {
+		t.Fatal("Order subtotal MUST be 37.50, found:", order.Subtotal())
+	}
+
+	cartFound, err := store.CartFindByID(ctx, cart.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !cartFound.IsConverted() || cartFound.OrderID() != order.ID() {
+		t.Fatal("Cart MUST be converted to the order, found:", cartFound.Status(), cartFound.OrderID())
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 7 {
+		t.Fatal("Product quantity MUST be 7, found:", productFound.QuantityInt())
+	}
+
+	if _, _, err := store.CartConvertToOrder(ctx, cart.ID()); err == nil {
+		t.Fatal("expected error, the cart is already converted")
+	}
+}
+
+func TestStoreCartConvertToOrderExpired(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetPriceMoney(NewMoney(1000, "USD")).
+		SetQuantityInt(10)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	cart := NewCart().SetSessionID("SESSION01")
+
+	if err := store.CartCreate(ctx, cart); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.CartItemAdd(ctx, cart, product, 1); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	cart.SetExpiresAt(carbon.Now(carbon.UTC).SubHour().ToDateTimeString(carbon.UTC))
+
+	if err := store.CartUpdate(ctx, cart); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, _, err := store.CartConvertToOrder(ctx, cart.ID()); err == nil {
+		t.Fatal("expected error, the cart has expired")
+	}
+
+	count, err := store.OrderCount(ctx, NewOrderQuery())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 0 {
+		t.Fatal("Order count MUST be 0, found:", count)
+	}
+}
@@ -55,36 +55,48 @@ func (store *Store) CategoryCount(ctx context.Context, options CategoryQueryInte
 	return i, nil
 }
 
+// CategoryCreate creates the category with a unique slug, the slug set or
+// else the slugified title, suffixed with a number if already taken
 func (store *Store) CategoryCreate(ctx context.Context, category CategoryInterface) error {
 	if category == nil {
 		return errors.New("category is nil")
 	}
 
+	if category.ParentID() == category.ID() {
+		return errors.New("category cannot be its own parent")
+	}
+
 	category.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
 	category.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
 	category.SetSoftDeletedAt(sb.MAX_DATETIME)
 
-	data := category.Data()
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		slug, err := store.slugUnique(txCtx, store.categoryTableName, category.ID(), category.Slug())
 
-	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
-		Insert(store.categoryTableName).
-		Prepared(true).
-		Rows(data).
-		ToSQL()
+		if err != nil {
+			return err
+		}
 
-	if errSql != nil {
-		return errSql
-	}
+		category.SetSlug(slug)
 
-	store.logSql("insert", sqlStr, params...)
+		data := category.Data()
 
-	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Insert(store.categoryTableName).
+			Prepared(true).
+			Rows(data).
+			ToSQL()
 
-	if err != nil {
-		return err
-	}
+		if errSql != nil {
+			return errSql
+		}
 
-	return nil
+		store.logSql("insert", sqlStr, params...)
+
+		_, err = database.Execute(store.toQuerableContext(txCtx), sqlStr, params...)
+
+		return err
+	})
 }
 
 func (store *Store) CategoryDelete(ctx context.Context, category CategoryInterface) error {
@@ -140,6 +152,40 @@ func (store *Store) CategoryFindByID(ctx context.Context, id string) (CategoryIn
 	return list[0], nil
 }
 
+// CategoryFindBySlug returns the category with the slug, or the category
+// the slug belonged to before its slug was changed. In the latter case the
+// slug of the category differs from the one looked for, so callers can
+// redirect to the current one. Returns nil if there is no such category.
+func (store *Store) CategoryFindBySlug(ctx context.Context, slug string) (CategoryInterface, error) {
+	if slug == "" {
+		return nil, errors.New("category slug is empty")
+	}
+
+	list, err := store.CategoryList(ctx, NewCategoryQuery().
+		SetSlug(slug).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	categoryID, err := store.slugRedirectEntityID(ctx, SLUG_REDIRECT_ENTITY_TYPE_CATEGORY, slug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if categoryID == "" {
+		return nil, nil
+	}
+
+	return store.CategoryFindByID(ctx, categoryID)
+}
+
 func (store *Store) CategoryList(ctx context.Context, options CategoryQueryInterface) ([]CategoryInterface, error) {
 	err := options.Validate()
 
@@ -207,11 +253,20 @@ func (store *Store) CategorySoftDeleteByID(ctx context.Context, id string) error
 	return store.CategorySoftDelete(ctx, category)
 }
 
+// CategoryUpdate updates the changed data of the category. A changed slug
+// is made unique, and the previous slug kept to redirect from
+// (see CategoryFindBySlug). Changing the title does not change the slug.
 func (store *Store) CategoryUpdate(ctx context.Context, category CategoryInterface) (err error) {
 	if category == nil {
 		return errors.New("category is nil")
 	}
 
+	if _, parentChanged := category.DataChanged()[COLUMN_PARENT_ID]; parentChanged {
+		if err := store.categoryValidateParent(ctx, category); err != nil {
+			return err
+		}
+	}
+
 	category.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
 
 	dataChanged := category.DataChanged()
@@ -224,6 +279,27 @@ func (store *Store) CategoryUpdate(ctx context.Context, category CategoryInterfa
 		return nil
 	}
 
+	if _, slugChanged := dataChanged[COLUMN_SLUG]; !slugChanged {
+		return store.categoryUpdateData(ctx, category, dataChanged)
+	}
+
+	// slug changes are recorded in the slug redirects,
+	// together with the update in one transaction
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		slug, err := store.slugChange(txCtx, store.categoryTableName, SLUG_REDIRECT_ENTITY_TYPE_CATEGORY, category.ID(), category.Slug())
+
+		if err != nil {
+			return err
+		}
+
+		category.SetSlug(slug)
+		dataChanged[COLUMN_SLUG] = slug
+
+		return store.categoryUpdateData(txCtx, category, dataChanged)
+	})
+}
+
+func (store *Store) categoryUpdateData(ctx context.Context, category CategoryInterface, dataChanged map[string]string) error {
 	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
 		Update(store.categoryTableName).
 		Prepared(true).
@@ -239,7 +315,7 @@ func (store *Store) CategoryUpdate(ctx context.Context, category CategoryInterfa
 		log.Println(sqlStr)
 	}
 
-	_, err = database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
 
 	if err != nil {
 		return err
@@ -248,7 +324,6 @@ func (store *Store) CategoryUpdate(ctx context.Context, category CategoryInterfa
 	category.MarkAsNotDirty()
 
 	return nil
-
 }
 
 func (store *Store) categoryQuery(options CategoryQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
@@ -274,6 +349,14 @@ func (store *Store) categoryQuery(options CategoryQueryInterface) (selectDataset
 		q = q.Where(goqu.C(COLUMN_PARENT_ID).Eq(options.ParentID()))
 	}
 
+	if options.HasSlug() {
+		q = q.Where(goqu.C(COLUMN_SLUG).Eq(options.Slug()))
+	}
+
+	if options.HasParentIDIn() {
+		q = q.Where(goqu.C(COLUMN_PARENT_ID).In(options.ParentIDIn()))
+	}
+
 	if options.HasStatus() {
 		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
 	}
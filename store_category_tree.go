@@ -0,0 +1,157 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+// CategoryAncestors returns the ancestors of the category, starting with
+// the root category and ending with the direct parent (i.e. breadcrumbs).
+// The category itself is not included.
+func (store *Store) CategoryAncestors(ctx context.Context, categoryID string) ([]CategoryInterface, error) {
+	if categoryID == "" {
+		return nil, errors.New("category id is empty")
+	}
+
+	category, err := store.CategoryFindByID(ctx, categoryID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("category not found")
+	}
+
+	ancestors := []CategoryInterface{}
+	visited := []string{category.ID()}
+	parentID := category.ParentID()
+
+	for parentID != "" {
+		if lo.Contains(visited, parentID) {
+			return nil, errors.New("category tree contains a cycle at category " + parentID)
+		}
+
+		parent, err := store.CategoryFindByID(ctx, parentID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if parent == nil {
+			break // orphaned branch, the parent no longer exists
+		}
+
+		ancestors = append([]CategoryInterface{parent}, ancestors...)
+		visited = append(visited, parent.ID())
+		parentID = parent.ParentID()
+	}
+
+	return ancestors, nil
+}
+
+// CategoryDescendants returns all the descendants of the category
+// (children, grandchildren, etc.), breadth first.
+// The category itself is not included.
+func (store *Store) CategoryDescendants(ctx context.Context, categoryID string) ([]CategoryInterface, error) {
+	if categoryID == "" {
+		return nil, errors.New("category id is empty")
+	}
+
+	return store.categoryDescendants(ctx, []string{categoryID})
+}
+
+// CategoryTree returns the categories below the root category as a tree.
+// If rootID is empty the whole tree, starting from the top level
+// categories (the ones without a parent), is returned.
+func (store *Store) CategoryTree(ctx context.Context, rootID string) ([]CategoryTreeNode, error) {
+	descendants, err := store.categoryDescendants(ctx, []string{rootID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	childrenByParentID := lo.GroupBy(descendants, func(category CategoryInterface) string {
+		return category.ParentID()
+	})
+
+	var buildNodes func(parentID string) []CategoryTreeNode
+
+	buildNodes = func(parentID string) []CategoryTreeNode {
+		nodes := []CategoryTreeNode{}
+
+		for _, child := range childrenByParentID[parentID] {
+			nodes = append(nodes, CategoryTreeNode{
+				Category: child,
+				Children: buildNodes(child.ID()),
+			})
+		}
+
+		return nodes
+	}
+
+	return buildNodes(rootID), nil
+}
+
+// categoryDescendants walks the ParentID links breadth first and returns
+// all the categories below the given parent IDs. Each category is
+// visited once, so a corrupted tree containing a cycle does not loop forever.
+func (store *Store) categoryDescendants(ctx context.Context, parentIDs []string) ([]CategoryInterface, error) {
+	descendants := []CategoryInterface{}
+	visited := lo.Uniq(parentIDs)
+
+	for len(parentIDs) > 0 {
+		children, err := store.CategoryList(ctx, NewCategoryQuery().
+			SetParentIDIn(parentIDs))
+
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs = []string{}
+
+		for _, child := range children {
+			if lo.Contains(visited, child.ID()) {
+				continue
+			}
+
+			descendants = append(descendants, child)
+			visited = append(visited, child.ID())
+			parentIDs = append(parentIDs, child.ID())
+		}
+	}
+
+	return descendants, nil
+}
+
+// categoryValidateParent checks that assigning the parent to the category
+// does not make the category its own ancestor
+func (store *Store) categoryValidateParent(ctx context.Context, category CategoryInterface) error {
+	parentID := category.ParentID()
+
+	if parentID == "" {
+		return nil
+	}
+
+	if parentID == category.ID() {
+		return errors.New("category cannot be its own parent")
+	}
+
+	descendants, err := store.categoryDescendants(ctx, []string{category.ID()})
+
+	if err != nil {
+		return err
+	}
+
+	isDescendant := lo.ContainsBy(descendants, func(descendant CategoryInterface) bool {
+		return descendant.ID() == parentID
+	})
+
+	if isDescendant {
+		return errors.New("category cannot be its own ancestor")
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func initCategoryTree(t *testing.T, store StoreInterface) (root, child, grandchild CategoryInterface) {
+	root = NewCategory().SetTitle("Clothing")
+	child = NewCategory().SetTitle("Shirts").SetParentID(root.ID())
+	grandchild = NewCategory().SetTitle("T-Shirts").SetParentID(child.ID())
+
+	for _, category := range []CategoryInterface{root, child, grandchild} {
+		if err := store.CategoryCreate(context.Background(), category); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	return root, child, grandchild
+}
+
+func TestStoreCategoryAncestors(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	root, child, grandchild := initCategoryTree(t, store)
+
+	ancestors, err := store.CategoryAncestors(context.Background(), grandchild.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(ancestors) != 2 {
+		t.Fatal("Ancestors MUST be 2, found:", len(ancestors))
+	}
+
+	if ancestors[0].ID() != root.ID() {
+		t.Fatal("First ancestor MUST be the root, found:", ancestors[0].Title())
+	}
+
+	if ancestors[1].ID() != child.ID() {
+		t.Fatal("Second ancestor MUST be the child, found:", ancestors[1].Title())
+	}
+}
+
+func TestStoreCategoryDescendants(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	root, child, grandchild := initCategoryTree(t, store)
+
+	descendants, err := store.CategoryDescendants(context.Background(), root.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(descendants) != 2 {
+		t.Fatal("Descendants MUST be 2, found:", len(descendants))
+	}
+
+	if descendants[0].ID() != child.ID() {
+		t.Fatal("First descendant MUST be the child, found:", descendants[0].Title())
+	}
+
+	if descendants[1].ID() != grandchild.ID() {
+		t.Fatal("Second descendant MUST be the grandchild, found:", descendants[1].Title())
+	}
+}
+
+func TestStoreCategoryTree(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	root, child, grandchild := initCategoryTree(t, store)
+
+	tree, err := store.CategoryTree(context.Background(), "")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(tree) != 1 {
+		t.Fatal("Tree MUST have 1 top level category, found:", len(tree))
+	}
+
+	if tree[0].Category.ID() != root.ID() {
+		t.Fatal("Top level category MUST be the root, found:", tree[0].Category.Title())
+	}
+
+	if len(tree[0].Children) != 1 || tree[0].Children[0].Category.ID() != child.ID() {
+		t.Fatal("Root MUST have the child as its only child")
+	}
+
+	if len(tree[0].Children[0].Children) != 1 || tree[0].Children[0].Children[0].Category.ID() != grandchild.ID() {
+		t.Fatal("Child MUST have the grandchild as its only child")
+	}
+
+	if tree[0].Children[0].Children[0].HasChildren() {
+		t.Fatal("Grandchild MUST NOT have children")
+	}
+
+	subtree, err := store.CategoryTree(context.Background(), child.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(subtree) != 1 || subtree[0].Category.ID() != grandchild.ID() {
+		t.Fatal("Subtree MUST contain only the grandchild")
+	}
+}
+
+func TestStoreCategoryUpdateCycle(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	root, _, grandchild := initCategoryTree(t, store)
+
+	ctx := context.Background()
+
+	root.SetParentID(grandchild.ID())
+
+	err = store.CategoryUpdate(ctx, root)
+
+	if err == nil {
+		t.Fatal("expected error, category cannot become its own ancestor")
+	}
+
+	root.SetParentID(root.ID())
+
+	err = store.CategoryUpdate(ctx, root)
+
+	if err == nil {
+		t.Fatal("expected error, category cannot be its own parent")
+	}
+
+	grandchild.SetParentID(root.ID())
+
+	err = store.CategoryUpdate(ctx, grandchild)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+}
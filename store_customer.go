@@ -0,0 +1,311 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) CustomerCount(ctx context.Context, options CustomerQueryInterface) (int64, error) {
+	q, _, err := store.customerQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) CustomerCreate(ctx context.Context, customer CustomerInterface) error {
+	customer.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	customer.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	customer.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := customer.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.customerTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	customer.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) CustomerDelete(ctx context.Context, customer CustomerInterface) error {
+	if customer == nil {
+		return errors.New("customer is nil")
+	}
+
+	return store.CustomerDeleteByID(ctx, customer.ID())
+}
+
+func (store *Store) CustomerDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("customer id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.customerTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// CustomerFindByEmail returns the customer with the email, nil if not found
+func (store *Store) CustomerFindByEmail(ctx context.Context, email string) (CustomerInterface, error) {
+	if email == "" {
+		return nil, errors.New("customer email is empty")
+	}
+
+	list, err := store.CustomerList(ctx, NewCustomerQuery().
+		SetEmail(email).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) CustomerFindByID(ctx context.Context, id string) (CustomerInterface, error) {
+	if id == "" {
+		return nil, errors.New("customer id is empty")
+	}
+
+	list, err := store.CustomerList(ctx, NewCustomerQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) CustomerList(ctx context.Context, options CustomerQueryInterface) ([]CustomerInterface, error) {
+	q, columns, err := store.customerQuery(options)
+
+	if err != nil {
+		return []CustomerInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []CustomerInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []CustomerInterface{}, err
+	}
+
+	list := []CustomerInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewCustomerFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) CustomerSoftDelete(ctx context.Context, customer CustomerInterface) error {
+	if customer == nil {
+		return errors.New("customer is nil")
+	}
+
+	customer.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.CustomerUpdate(ctx, customer)
+}
+
+func (store *Store) CustomerSoftDeleteByID(ctx context.Context, id string) error {
+	customer, err := store.CustomerFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.CustomerSoftDelete(ctx, customer)
+}
+
+func (store *Store) CustomerUpdate(ctx context.Context, customer CustomerInterface) error {
+	if customer == nil {
+		return errors.New("customer is nil")
+	}
+
+	customer.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := customer.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.customerTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(customer.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	customer.MarkAsNotDirty()
+
+	return err
+}
+
+func (store *Store) customerQuery(options CustomerQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewCustomerQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.customerTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasIDIn() {
+		q = q.Where(goqu.C(COLUMN_ID).In(options.IDIn()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if options.HasStatusIn() {
+		q = q.Where(goqu.C(COLUMN_STATUS).In(options.StatusIn()))
+	}
+
+	if options.HasEmail() {
+		q = q.Where(goqu.C(COLUMN_EMAIL).Eq(options.Email()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted customers requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
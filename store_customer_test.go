@@ -0,0 +1,159 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreCustomerCreateAndFind(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	customer := NewCustomer().
+		SetEmail("jane@example.com").
+		SetFirstName("Jane").
+		SetLastName("Doe").
+		SetPhone("+44 20 7946 0000")
+
+	if err := store.CustomerCreate(ctx, customer); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	customerFound, err := store.CustomerFindByEmail(ctx, "jane@example.com")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if customerFound == nil {
+		t.Fatal("Customer MUST NOT be nil")
+	}
+
+	if customerFound.Name() != "Jane Doe" {
+		t.Fatal("Customer name MUST be Jane Doe, found:", customerFound.Name())
+	}
+
+	customerFound.SetStatus(CUSTOMER_STATUS_INACTIVE)
+
+	if err := store.CustomerUpdate(ctx, customerFound); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	count, err := store.CustomerCount(ctx, NewCustomerQuery().SetStatus(CUSTOMER_STATUS_INACTIVE))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Inactive customer count MUST be 1, found:", count)
+	}
+
+	if err := store.CustomerSoftDeleteByID(ctx, customer.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	customerFound, err = store.CustomerFindByID(ctx, customer.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if customerFound != nil {
+		t.Fatal("Customer MUST be nil after soft delete")
+	}
+}
+
+func TestStoreAddressSnapshotOnOrder(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	customer := NewCustomer().SetEmail("jane@example.com")
+
+	if err := store.CustomerCreate(ctx, customer); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	billing := NewAddress().
+		SetCustomerID(customer.ID()).
+		SetType(ADDRESS_TYPE_BILLING).
+		SetAddressLine1("1 High Street").
+		SetCity("London").
+		SetPostcode("SW1A 1AA").
+		SetCountry("gb")
+
+	shipping := NewAddress().
+		SetCustomerID(customer.ID()).
+		SetAddressLine1("2 Low Road").
+		SetCity("Leeds").
+		SetPostcode("LS1 1AA").
+		SetCountry("GB")
+
+	for _, address := range []AddressInterface{billing, shipping} {
+		if err := store.AddressCreate(ctx, address); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	list, err := store.AddressList(ctx, NewAddressQuery().
+		SetCustomerID(customer.ID()).
+		SetType(ADDRESS_TYPE_BILLING))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].Country() != "GB" {
+		t.Fatal("Customer MUST have 1 billing address in GB, found:", len(list))
+	}
+
+	order := NewOrder().
+		SetCustomerID(customer.ID()).
+		SetBillingAddress(billing).
+		SetShippingAddress(shipping)
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Changing the address book does not change the order
+	shipping.SetCity("York")
+
+	if err := store.AddressUpdate(ctx, shipping); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.ShippingAddress() == nil || orderFound.ShippingAddress().City() != "Leeds" {
+		t.Fatal("Order shipping address MUST be the snapshot in Leeds")
+	}
+
+	if orderFound.BillingAddress() == nil || orderFound.BillingAddress().Postcode() != "SW1A 1AA" {
+		t.Fatal("Order billing address MUST be the snapshot with postcode SW1A 1AA")
+	}
+
+	addressFound, err := store.AddressFindByID(ctx, shipping.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if addressFound.City() != "York" {
+		t.Fatal("Address city MUST be York, found:", addressFound.City())
+	}
+}
@@ -0,0 +1,140 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+)
+
+// DiscountApply finds the active discount with the given code, checks it is
+// valid today, calculates the reduction for the line items of the order and
+// records the applied discount against the order (in the order metas).
+//
+// Percent discounts are capped at 100%, amount discounts at the order total.
+// Applying a discount replaces any discount previously applied to the order.
+// The use of the discount is recorded as a redemption, so the discount
+// maximum uses and maximum uses per customer are enforced.
+//
+// Returns the reduction amount.
+func (store *Store) DiscountApply(ctx context.Context, code string, order OrderInterface, lineItems []OrderLineItemInterface) (float64, error) {
+	if order == nil {
+		return 0, errors.New("order is nil")
+	}
+
+	if len(lineItems) < 1 {
+		return 0, errors.New("order has no line items")
+	}
+
+	discount, err := store.DiscountFindByCode(ctx, code)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if discount == nil {
+		return 0, errors.New("discount with code " + code + " not found")
+	}
+
+	if err := discountValidateDates(discount); err != nil {
+		return 0, err
+	}
+
+	total := NewMoney(0, lineItems[0].Currency())
+
+	for _, lineItem := range lineItems {
+		if lineItem.Currency() != total.Currency() {
+			return 0, errors.New("order line items have different currencies")
+		}
+
+		total = total.Add(lineItem.PriceMoney().Mul(lineItem.QuantityInt()))
+	}
+
+	if discount.Type() == DISCOUNT_TYPE_AMOUNT && discount.Currency() != total.Currency() {
+		return 0, errors.New("discount " + discount.Code() + " is in " + discount.Currency() + ", the order is in " + total.Currency())
+	}
+
+	reduction := discountCalculateReduction(discount, total)
+
+	err = store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		// The previously applied discount no longer counts as used
+		if err := store.discountRedemptionDeleteByOrderID(txCtx, order.ID(), discount.ID()); err != nil {
+			return err
+		}
+
+		if err := store.DiscountRedeem(txCtx, discount, order); err != nil {
+			return err
+		}
+
+		err := order.UpsertMetas(map[string]string{
+			ORDER_META_DISCOUNT_ID:     discount.ID(),
+			ORDER_META_DISCOUNT_CODE:   discount.Code(),
+			ORDER_META_DISCOUNT_AMOUNT: reduction.String(),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return store.OrderUpdate(txCtx, order)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return reduction.Float(), nil
+}
+
+// discountCalculateReduction returns the reduction the discount gives
+// on the total, never negative and never more than the total
+func discountCalculateReduction(discount DiscountInterface, total Money) Money {
+	zero := NewMoney(0, total.Currency())
+
+	if !total.IsPositive() {
+		return zero
+	}
+
+	// The amount is kept in minor units, which for percent
+	// discounts are hundredths of a percent
+	amount := max(discount.AmountMoney().Amount(), 0)
+
+	reduction := zero
+
+	switch discount.Type() {
+	case DISCOUNT_TYPE_PERCENT:
+		reduction = total.Percent(min(amount, 100*moneyMinorUnitsPerUnit))
+	case DISCOUNT_TYPE_AMOUNT:
+		reduction = NewMoney(amount, total.Currency())
+	}
+
+	return reduction.Min(total)
+}
+
+// discountValidateDates checks the current time is within the start and
+// end dates of the discount. Empty (NULL) dates are not restricting.
+func discountValidateDates(discount DiscountInterface) error {
+	now := carbon.Now(carbon.UTC)
+
+	startsAt := discount.StartsAtCarbon()
+
+	if !discountDateIsNull(startsAt) && startsAt.Gt(now) {
+		return errors.New("discount " + discount.Code() + " has not started yet")
+	}
+
+	endsAt := discount.EndsAtCarbon()
+
+	if !discountDateIsNull(endsAt) && endsAt.Lt(now) {
+		return errors.New("discount " + discount.Code() + " has expired")
+	}
+
+	return nil
+}
+
+// discountDateIsNull returns true for missing dates and dates set to
+// sb.NULL_DATETIME, which is the default for the discount start and end
+func discountDateIsNull(date *carbon.Carbon) bool {
+	return date.IsInvalid() || date.ToDateTimeString(carbon.UTC) == sb.NULL_DATETIME
+}
@@ -0,0 +1,103 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/spf13/cast"
+)
+
+func TestStoreDiscountApply(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	percent := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_PERCENT).
+		SetAmount(10).
+		SetCode("TEN_PERCENT")
+
+	tooMuch := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_AMOUNT).
+		SetAmount(500).
+		SetCode("FIVE_HUNDRED_OFF")
+
+	expired := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_AMOUNT).
+		SetAmount(5).
+		SetCode("EXPIRED").
+		SetEndsAt(carbon.Now(carbon.UTC).SubDay().ToDateTimeString(carbon.UTC))
+
+	for _, discount := range []DiscountInterface{percent, tooMuch, expired} {
+		if err := store.DiscountCreate(ctx, discount); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	err = store.OrderCreate(ctx, order)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetOrderID(order.ID()).SetPriceFloat(20).SetQuantityInt(2),
+		NewOrderLineItem().SetOrderID(order.ID()).SetPriceFloat(10).SetQuantityInt(1),
+	}
+
+	reduction, err := store.DiscountApply(ctx, "TEN_PERCENT", order, lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if reduction != 5 {
+		t.Fatal("Reduction MUST be 5, found:", reduction)
+	}
+
+	reduction, err = store.DiscountApply(ctx, "FIVE_HUNDRED_OFF", order, lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if reduction != 50 {
+		t.Fatal("Reduction MUST be capped at the order total 50, found:", reduction)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Meta(ORDER_META_DISCOUNT_CODE) != "FIVE_HUNDRED_OFF" {
+		t.Fatal("Order discount code MUST be FIVE_HUNDRED_OFF, found:", orderFound.Meta(ORDER_META_DISCOUNT_CODE))
+	}
+
+	if cast.ToFloat64(orderFound.Meta(ORDER_META_DISCOUNT_AMOUNT)) != 50 {
+		t.Fatal("Order discount amount MUST be 50, found:", orderFound.Meta(ORDER_META_DISCOUNT_AMOUNT))
+	}
+
+	_, err = store.DiscountApply(ctx, "EXPIRED", order, lineItems)
+
+	if err == nil {
+		t.Fatal("expected error, discount has expired")
+	}
+
+	_, err = store.DiscountApply(ctx, "UNKNOWN", order, lineItems)
+
+	if err == nil {
+		t.Fatal("expected error, discount does not exist")
+	}
+}
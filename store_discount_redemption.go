@@ -0,0 +1,280 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) DiscountRedemptionCount(ctx context.Context, options DiscountRedemptionQueryInterface) (int64, error) {
+	q, _, err := store.discountRedemptionQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) DiscountRedemptionList(ctx context.Context, options DiscountRedemptionQueryInterface) ([]DiscountRedemptionInterface, error) {
+	q, columns, err := store.discountRedemptionQuery(options)
+
+	if err != nil {
+		return []DiscountRedemptionInterface{}, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []DiscountRedemptionInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []DiscountRedemptionInterface{}, err
+	}
+
+	list := []DiscountRedemptionInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewDiscountRedemptionFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+// DiscountRedeem records the use of the discount by the order, enforcing
+// the maximum total uses and the maximum uses per customer of the discount.
+// Redeeming the same discount for the same order again is a no-op.
+func (store *Store) DiscountRedeem(ctx context.Context, discount DiscountInterface, order OrderInterface) error {
+	if discount == nil {
+		return errors.New("discount is nil")
+	}
+
+	if order == nil {
+		return errors.New("order is nil")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		// Touching the discount row first locks it until the transaction
+		// ends, so concurrent redemptions of the same discount are counted
+		// one after the other and cannot exceed the limits
+		if err := store.discountLock(txCtx, discount.ID()); err != nil {
+			return err
+		}
+
+		existing, err := store.DiscountRedemptionCount(txCtx, NewDiscountRedemptionQuery().
+			SetDiscountID(discount.ID()).
+			SetOrderID(order.ID()))
+
+		if err != nil {
+			return err
+		}
+
+		if existing > 0 {
+			return nil
+		}
+
+		if discount.MaxUses() > 0 {
+			uses, err := store.DiscountRedemptionCount(txCtx, NewDiscountRedemptionQuery().
+				SetDiscountID(discount.ID()))
+
+			if err != nil {
+				return err
+			}
+
+			if uses >= discount.MaxUses() {
+				return errors.New("discount " + discount.Code() + " has reached its maximum uses")
+			}
+		}
+
+		if discount.MaxUsesPerCustomer() > 0 && order.CustomerID() != "" {
+			uses, err := store.DiscountRedemptionCount(txCtx, NewDiscountRedemptionQuery().
+				SetDiscountID(discount.ID()).
+				SetCustomerID(order.CustomerID()))
+
+			if err != nil {
+				return err
+			}
+
+			if uses >= discount.MaxUsesPerCustomer() {
+				return errors.New("discount " + discount.Code() + " has reached its maximum uses for this customer")
+			}
+		}
+
+		redemption := NewDiscountRedemption().
+			SetDiscountID(discount.ID()).
+			SetOrderID(order.ID()).
+			SetCustomerID(order.CustomerID())
+
+		return store.discountRedemptionCreate(txCtx, redemption)
+	})
+}
+
+func (store *Store) discountLock(ctx context.Context, discountID string) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.discountTableName).
+		Prepared(true).
+		Set(map[string]string{
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(discountID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) discountRedemptionCreate(ctx context.Context, redemption DiscountRedemptionInterface) error {
+	redemption.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	data := redemption.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.discountRedemptionTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	redemption.MarkAsNotDirty()
+
+	return nil
+}
+
+// discountRedemptionDeleteByOrderID removes the redemptions of the order,
+// except the one of the discount with the keep ID
+func (store *Store) discountRedemptionDeleteByOrderID(ctx context.Context, orderID string, keepDiscountID string) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.discountRedemptionTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ORDER_ID).Eq(orderID)).
+		Where(goqu.C(COLUMN_DISCOUNT_ID).Neq(keepDiscountID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) discountRedemptionQuery(options DiscountRedemptionQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		return nil, nil, errors.New("discount redemption options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.discountRedemptionTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasDiscountID() {
+		q = q.Where(goqu.C(COLUMN_DISCOUNT_ID).Eq(options.DiscountID()))
+	}
+
+	if options.HasOrderID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Eq(options.OrderID()))
+	}
+
+	if options.HasCustomerID() {
+		q = q.Where(goqu.C(COLUMN_CUSTOMER_ID).Eq(options.CustomerID()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	return q, columns, nil
+}
@@ -0,0 +1,138 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreDiscountRedeemMaxUses(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	discount := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_AMOUNT).
+		SetAmount(5).
+		SetCode("TWICE_ONLY").
+		SetMaxUses(2)
+
+	if err := store.DiscountCreate(ctx, discount); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orders := []OrderInterface{}
+
+	for _, customerID := range []string{"CUSTOMER01_ID", "CUSTOMER02_ID", "CUSTOMER03_ID"} {
+		order := NewOrder().SetCustomerID(customerID)
+
+		if err := store.OrderCreate(ctx, order); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		orders = append(orders, order)
+	}
+
+	if err := store.DiscountRedeem(ctx, discount, orders[0]); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Redeeming again for the same order MUST not count as another use
+	if err := store.DiscountRedeem(ctx, discount, orders[0]); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.DiscountRedeem(ctx, discount, orders[1]); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.DiscountRedeem(ctx, discount, orders[2]); err == nil {
+		t.Fatal("expected error, discount has reached its maximum uses")
+	}
+
+	count, err := store.DiscountRedemptionCount(ctx, NewDiscountRedemptionQuery().SetDiscountID(discount.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 2 {
+		t.Fatal("Redemption count MUST be 2, found:", count)
+	}
+}
+
+func TestStoreDiscountRedeemMaxUsesPerCustomer(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	discount := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_PERCENT).
+		SetAmount(10).
+		SetCode("ONCE_PER_CUSTOMER").
+		SetMaxUsesPerCustomer(1)
+
+	if err := store.DiscountCreate(ctx, discount); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order1 := NewOrder().SetCustomerID("CUSTOMER01_ID")
+	order2 := NewOrder().SetCustomerID("CUSTOMER01_ID")
+	order3 := NewOrder().SetCustomerID("CUSTOMER02_ID")
+
+	for _, order := range []OrderInterface{order1, order2, order3} {
+		if err := store.OrderCreate(ctx, order); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetPriceFloat(20).SetQuantityInt(1),
+	}
+
+	if _, err := store.DiscountApply(ctx, "ONCE_PER_CUSTOMER", order1, lineItems); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.DiscountApply(ctx, "ONCE_PER_CUSTOMER", order2, lineItems); err == nil {
+		t.Fatal("expected error, discount has reached its maximum uses for this customer")
+	}
+
+	if _, err := store.DiscountApply(ctx, "ONCE_PER_CUSTOMER", order3, lineItems); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order2Found, err := store.OrderFindByID(ctx, order2.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if order2Found.Meta(ORDER_META_DISCOUNT_CODE) != "" {
+		t.Fatal("Order discount code MUST be empty, found:", order2Found.Meta(ORDER_META_DISCOUNT_CODE))
+	}
+
+	list, err := store.DiscountRedemptionList(ctx, NewDiscountRedemptionQuery().
+		SetCustomerID("CUSTOMER01_ID"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatal("Redemption list MUST have 1 item, found:", len(list))
+	}
+
+	if list[0].OrderID() != order1.ID() {
+		t.Fatal("Redemption order ID MUST be", order1.ID(), "found:", list[0].OrderID())
+	}
+}
@@ -0,0 +1,61 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreDiscountDuration(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	once := NewDiscount().SetDuration(DISCOUNT_DURATION_ONCE)
+	months := NewDiscount().SetDuration(DISCOUNT_DURATION_MONTHS).SetDurationInMonths(3)
+	forever := NewDiscount().SetDuration(DISCOUNT_DURATION_FOREVER)
+
+	for _, discount := range []DiscountInterface{once, months, forever} {
+		if err := store.DiscountCreate(ctx, discount); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	monthsFound, err := store.DiscountFindByID(ctx, months.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if monthsFound.Duration() != DISCOUNT_DURATION_MONTHS {
+		t.Fatal("Discount duration MUST be months, found:", monthsFound.Duration())
+	}
+
+	if monthsFound.DurationInMonths() != 3 {
+		t.Fatal("Discount duration in months MUST be 3, found:", monthsFound.DurationInMonths())
+	}
+
+	tests := []struct {
+		discount DiscountInterface
+		cycle    int
+		expected bool
+	}{
+		{once, 1, true},
+		{once, 2, false},
+		{monthsFound, 1, true},
+		{monthsFound, 3, true},
+		{monthsFound, 4, false},
+		{forever, 1, true},
+		{forever, 100, true},
+		{forever, 0, false},
+	}
+
+	for _, test := range tests {
+		if test.discount.AppliesToBillingCycle(test.cycle) != test.expected {
+			t.Fatal("Discount with duration", test.discount.Duration(), "MUST apply to cycle", test.cycle, ":", test.expected)
+		}
+	}
+}
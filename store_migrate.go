@@ -0,0 +1,200 @@
+package shopstore
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+)
+
+// tableColumnsMigrate adds the columns missing from the tables created
+// before the columns were added (see tableColumnsAdded). The columns are
+// added nullable, as not every database can add a NOT NULL column to
+// a table with rows, and set to their value for the rows stored before.
+func (store *Store) tableColumnsMigrate() error {
+	existing := map[string][]string{}
+
+	for _, added := range store.tableColumnsAdded() {
+		if _, read := existing[added.tableName]; !read {
+			columns, err := store.tableColumnNames(added.tableName)
+
+			if err != nil {
+				return err
+			}
+
+			existing[added.tableName] = columns
+		}
+
+		if lo.Contains(existing[added.tableName], added.column.Name) {
+			continue
+		}
+
+		column := added.column
+		column.Nullable = true
+
+		sqlStr, err := sb.NewBuilder(store.dbDriverName).TableColumnAdd(added.tableName, column)
+
+		if err != nil {
+			return err
+		}
+
+		store.logSql("alter", sqlStr)
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+
+		existing[added.tableName] = append(existing[added.tableName], column.Name)
+
+		if added.value == nil {
+			continue
+		}
+
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Update(added.tableName).
+			Prepared(true).
+			Set(goqu.Record{column.Name: added.value}).
+			Where(goqu.C(column.Name).IsNull()).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("update", sqlStr, params...)
+
+		if _, err := store.db.Exec(sqlStr, params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableColumnNames returns the names of the columns of the table
+func (store *Store) tableColumnNames(tableName string) ([]string, error) {
+	sqlStr, _, errSql := goqu.Dialect(store.dbDriverName).
+		From(tableName).
+		Where(goqu.L("1 = 0")).
+		ToSQL()
+
+	if errSql != nil {
+		return nil, errSql
+	}
+
+	rows, err := store.db.Query(sqlStr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return rows.Columns()
+}
+
+// tableIndexesMigrate creates the indexes of the tables not created yet
+func (store *Store) tableIndexesMigrate() error {
+	for _, index := range store.tableIndexes() {
+		exists, err := store.indexExists(index)
+
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		sqlStr := store.sqlIndexCreate(index)
+
+		store.logSql("create", sqlStr)
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexExists returns true if the index has been created already. Only
+// checked on MySQL and MSSQL, the other databases create indexes only
+// if they do not exist (see sqlIndexCreate).
+func (store *Store) indexExists(index tableIndex) (bool, error) {
+	count := 0
+
+	switch store.dbDriverName {
+	case sb.DIALECT_MYSQL:
+		sqlStr := `SELECT COUNT(*) FROM information_schema.statistics` +
+			` WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?`
+
+		if err := store.db.QueryRow(sqlStr, index.tableName, index.name()).Scan(&count); err != nil {
+			return false, err
+		}
+	case sb.DIALECT_MSSQL:
+		sqlStr := `SELECT COUNT(*) FROM sys.indexes WHERE name = '` + index.name() + `'`
+
+		if err := store.db.QueryRow(sqlStr).Scan(&count); err != nil {
+			return false, err
+		}
+	}
+
+	return count > 0, nil
+}
+
+// slugsMigrate sets the slugs of the products and categories stored before
+// slugs were, from their titles (see slugUnique)
+func (store *Store) slugsMigrate() error {
+	ctx := context.Background()
+
+	for _, tableName := range []string{store.categoryTableName, store.productTableName} {
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			From(tableName).
+			Prepared(true).
+			Select(COLUMN_ID, COLUMN_TITLE).
+			Where(goqu.C(COLUMN_SLUG).IsNull()).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("select", sqlStr, params...)
+
+		mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+		if err != nil {
+			return err
+		}
+
+		for _, row := range mapped {
+			slug, err := store.slugUnique(ctx, tableName, row[COLUMN_ID], row[COLUMN_TITLE])
+
+			if err != nil {
+				return err
+			}
+
+			sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+				Update(tableName).
+				Prepared(true).
+				Set(goqu.Record{COLUMN_SLUG: slug}).
+				Where(goqu.C(COLUMN_ID).Eq(row[COLUMN_ID])).
+				ToSQL()
+
+			if errSql != nil {
+				return errSql
+			}
+
+			store.logSql("update", sqlStr, params...)
+
+			if _, err := store.db.Exec(sqlStr, params...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
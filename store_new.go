@@ -3,22 +3,54 @@ package shopstore
 import (
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/gouniverse/sb"
 )
 
-// NewStoreOptions define the options for creating a new block store
+// NewStoreOptions define the options for creating a new block store.
+// The category, discount, media, order, order line item and product table
+// names are required, the names of the other tables have defaults derived
+// from them, i.e. "shop_product_price" for the "shop_product" table.
 type NewStoreOptions struct {
-	CategoryTableName      string
-	DiscountTableName      string
-	MediaTableName         string
-	OrderTableName         string
-	OrderLineItemTableName string
-	ProductTableName       string
-	DB                     *sql.DB
-	DbDriverName           string
-	AutomigrateEnabled     bool
-	DebugEnabled           bool
+	CategoryTableName           string
+	DiscountTableName           string
+	DiscountRedemptionTableName string
+	MediaTableName              string
+	OrderTableName              string
+	OrderLineItemTableName      string
+	OrderStatusHistoryTableName string
+	ProductTableName            string
+	ProductCategoryTableName    string
+	ProductPriceTableName       string
+	TaxRateTableName            string
+	ShippingMethodTableName     string
+	ShipmentTableName           string
+	ShipmentLineTableName       string
+	RefundTableName             string
+	PaymentTableName            string
+	CustomerTableName           string
+	AddressTableName            string
+	CartTableName               string
+	CartItemTableName           string
+	StockReservationTableName   string
+	ProductOptionTableName      string
+	ProductOptionValueTableName string
+	ProductVariantTableName     string
+	SlugRedirectTableName       string
+	ProductAttributeTableName   string
+	DB                          *sql.DB
+	DbDriverName                string
+	AutomigrateEnabled          bool
+	DebugEnabled                bool
+
+	// OrderStatusTransitions overrides the allowed order status transitions,
+	// keyed by current status. Defaults to DefaultOrderStatusTransitions()
+	OrderStatusTransitions map[string][]string
+
+	// StaleTimeoutSeconds is the age after which PurgeStale treats pending
+	// orders and expired carts as stale. Defaults to 2 hours
+	StaleTimeoutSeconds int64
 }
 
 // NewStore creates a new block store
@@ -51,24 +83,142 @@ func NewStore(opts NewStoreOptions) (*Store, error) {
 		return nil, errors.New("shop store: DB is required")
 	}
 
+	// The tables added later default to names next to the tables they
+	// belong with, or else to the prefix of the product table name
+	prefix := strings.TrimSuffix(opts.ProductTableName, "product")
+
+	if prefix == opts.ProductTableName {
+		prefix += "_"
+	}
+
+	if opts.DiscountRedemptionTableName == "" {
+		opts.DiscountRedemptionTableName = opts.DiscountTableName + "_redemption"
+	}
+
+	if opts.OrderStatusHistoryTableName == "" {
+		opts.OrderStatusHistoryTableName = opts.OrderTableName + "_status_history"
+	}
+
+	if opts.ProductCategoryTableName == "" {
+		opts.ProductCategoryTableName = opts.ProductTableName + "_category"
+	}
+
+	if opts.ProductPriceTableName == "" {
+		opts.ProductPriceTableName = opts.ProductTableName + "_price"
+	}
+
+	if opts.TaxRateTableName == "" {
+		opts.TaxRateTableName = prefix + "tax_rate"
+	}
+
+	if opts.ShippingMethodTableName == "" {
+		opts.ShippingMethodTableName = prefix + "shipping_method"
+	}
+
+	if opts.ShipmentTableName == "" {
+		opts.ShipmentTableName = prefix + "shipment"
+	}
+
+	if opts.ShipmentLineTableName == "" {
+		opts.ShipmentLineTableName = opts.ShipmentTableName + "_line"
+	}
+
+	if opts.RefundTableName == "" {
+		opts.RefundTableName = prefix + "refund"
+	}
+
+	if opts.PaymentTableName == "" {
+		opts.PaymentTableName = prefix + "payment"
+	}
+
+	if opts.CustomerTableName == "" {
+		opts.CustomerTableName = prefix + "customer"
+	}
+
+	if opts.AddressTableName == "" {
+		opts.AddressTableName = prefix + "address"
+	}
+
+	if opts.CartTableName == "" {
+		opts.CartTableName = prefix + "cart"
+	}
+
+	if opts.CartItemTableName == "" {
+		opts.CartItemTableName = opts.CartTableName + "_item"
+	}
+
+	if opts.StockReservationTableName == "" {
+		opts.StockReservationTableName = prefix + "stock_reservation"
+	}
+
+	if opts.ProductOptionTableName == "" {
+		opts.ProductOptionTableName = opts.ProductTableName + "_option"
+	}
+
+	if opts.ProductOptionValueTableName == "" {
+		opts.ProductOptionValueTableName = opts.ProductOptionTableName + "_value"
+	}
+
+	if opts.ProductVariantTableName == "" {
+		opts.ProductVariantTableName = opts.ProductTableName + "_variant"
+	}
+
+	if opts.SlugRedirectTableName == "" {
+		opts.SlugRedirectTableName = prefix + "slug_redirect"
+	}
+
+	if opts.ProductAttributeTableName == "" {
+		opts.ProductAttributeTableName = opts.ProductTableName + "_attribute"
+	}
+
 	if opts.DbDriverName == "" {
 		opts.DbDriverName = sb.DatabaseDriverName(opts.DB)
 	}
 
 	store := &Store{
-		categoryTableName:      opts.CategoryTableName,
-		discountTableName:      opts.DiscountTableName,
-		mediaTableName:         opts.MediaTableName,
-		orderTableName:         opts.OrderTableName,
-		orderLineItemTableName: opts.OrderLineItemTableName,
-		productTableName:       opts.ProductTableName,
-		automigrateEnabled:     opts.AutomigrateEnabled,
-		db:                     opts.DB,
-		dbDriverName:           opts.DbDriverName,
-		debugEnabled:           opts.DebugEnabled,
+		categoryTableName:           opts.CategoryTableName,
+		discountTableName:           opts.DiscountTableName,
+		discountRedemptionTableName: opts.DiscountRedemptionTableName,
+		mediaTableName:              opts.MediaTableName,
+		orderTableName:              opts.OrderTableName,
+		orderLineItemTableName:      opts.OrderLineItemTableName,
+		orderStatusHistoryTableName: opts.OrderStatusHistoryTableName,
+		productTableName:            opts.ProductTableName,
+		productCategoryTableName:    opts.ProductCategoryTableName,
+		productPriceTableName:       opts.ProductPriceTableName,
+		taxRateTableName:            opts.TaxRateTableName,
+		shippingMethodTableName:     opts.ShippingMethodTableName,
+		shipmentTableName:           opts.ShipmentTableName,
+		shipmentLineTableName:       opts.ShipmentLineTableName,
+		refundTableName:             opts.RefundTableName,
+		paymentTableName:            opts.PaymentTableName,
+		customerTableName:           opts.CustomerTableName,
+		addressTableName:            opts.AddressTableName,
+		cartTableName:               opts.CartTableName,
+		cartItemTableName:           opts.CartItemTableName,
+		stockReservationTableName:   opts.StockReservationTableName,
+		productOptionTableName:      opts.ProductOptionTableName,
+		productOptionValueTableName: opts.ProductOptionValueTableName,
+		productVariantTableName:     opts.ProductVariantTableName,
+		slugRedirectTableName:       opts.SlugRedirectTableName,
+		productAttributeTableName:   opts.ProductAttributeTableName,
+		automigrateEnabled:          opts.AutomigrateEnabled,
+		db:                          opts.DB,
+		dbDriverName:                opts.DbDriverName,
+		debugEnabled:                opts.DebugEnabled,
 	}
 
-	store.timeoutSeconds = 2 * 60 * 60 // 2 hours
+	store.timeoutSeconds = opts.StaleTimeoutSeconds
+
+	if store.timeoutSeconds <= 0 {
+		store.timeoutSeconds = 2 * 60 * 60 // 2 hours
+	}
+
+	store.orderStatusTransitions = opts.OrderStatusTransitions
+
+	if store.orderStatusTransitions == nil {
+		store.orderStatusTransitions = DefaultOrderStatusTransitions()
+	}
 
 	if store.automigrateEnabled {
 		err := store.AutoMigrate()
@@ -76,7 +226,18 @@ func NewStore(opts NewStoreOptions) (*Store, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		return store, nil
+	}
+
+	// The full-text search of the products set up by an earlier AutoMigrate
+	fullText, err := store.productSearchIndexExists()
+
+	if err != nil {
+		return nil, err
 	}
 
+	store.productSearchFullText = fullText
+
 	return store, nil
 }
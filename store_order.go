@@ -207,11 +207,78 @@ func (store *Store) OrderUpdate(ctx context.Context, order OrderInterface) error
 		return nil
 	}
 
+	newStatus, statusChanged := dataChanged[COLUMN_STATUS]
+
+	if !statusChanged {
+		if err := store.orderUpdateData(ctx, order.ID(), dataChanged); err != nil {
+			return err
+		}
+
+		order.MarkAsNotDirty()
+
+		return nil
+	}
+
+	// status changes must be allowed by the order status transitions, are
+	// recorded in the order status history and settle the stock
+	// reservations, together with the update in one transaction
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		previous, err := store.OrderFindByID(txCtx, order.ID())
+
+		if err != nil {
+			return err
+		}
+
+		if previous != nil && !store.OrderStatusTransitionAllowed(previous.Status(), newStatus) {
+			return &OrderStatusTransitionError{
+				OrderID:    order.ID(),
+				FromStatus: previous.Status(),
+				ToStatus:   newStatus,
+			}
+		}
+
+		if err := store.orderUpdateData(txCtx, order.ID(), dataChanged); err != nil {
+			return err
+		}
+
+		if previous == nil || previous.Status() == newStatus {
+			return nil
+		}
+
+		if err := store.orderStatusHistoryRecord(txCtx, order.ID(), previous.Status(), newStatus); err != nil {
+			return err
+		}
+
+		return store.stockReservationsApplyOrderStatus(txCtx, order.ID(), newStatus)
+	})
+
+	// The order keeps its changes, so the update can be retried, and the
+	// status it is persisted with if the status change is not allowed
+	var transitionErr *OrderStatusTransitionError
+
+	if errors.As(err, &transitionErr) {
+		order.SetStatus(transitionErr.FromStatus)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	order.MarkAsNotDirty()
+
+	return nil
+}
+
+// orderLock touches the order, so concurrent transactions checking what
+// was paid, refunded or shipped for it wait for each other
+func (store *Store) orderLock(ctx context.Context, orderID string) error {
 	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
 		Update(store.orderTableName).
 		Prepared(true).
-		Set(dataChanged).
-		Where(goqu.C("id").Eq(order.ID())).
+		Set(map[string]string{
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(orderID)).
 		ToSQL()
 
 	if errSql != nil {
@@ -222,7 +289,24 @@ func (store *Store) OrderUpdate(ctx context.Context, order OrderInterface) error
 
 	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
 
-	order.MarkAsNotDirty()
+	return err
+}
+
+func (store *Store) orderUpdateData(ctx context.Context, orderID string, dataChanged map[string]string) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.orderTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C("id").Eq(orderID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
 
 	return err
 }
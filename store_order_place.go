@@ -0,0 +1,233 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+)
+
+// PlaceOrder creates the order together with its line items and takes the
+// stock of the ordered products, all in a single database transaction.
+// The stock taken is recorded as consumed stock reservations of the order,
+// so it is put back if the order is cancelled or declined.
+// The totals of the order are calculated from the line items.
+// Orders of a customer without a billing or shipping address set get a
+// copy of the latest address of that type from the address book of the
+// customer (see orderAddressesSnapshot).
+//
+// Every line item must reference an active product with enough stock
+// and be priced in the currency of the order, otherwise nothing is
+// persisted and an error is returned. Line items referencing a variant
+// of the product take the stock of the variant instead.
+func (store *Store) PlaceOrder(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) error {
+	if order == nil {
+		return errors.New("order is nil")
+	}
+
+	if len(lineItems) < 1 {
+		return errors.New("order has no line items")
+	}
+
+	for _, lineItem := range lineItems {
+		if lineItem == nil {
+			return errors.New("order line item is nil")
+		}
+
+		if lineItem.ProductID() == "" {
+			return errors.New("order line item product id is empty")
+		}
+
+		if lineItem.QuantityInt() < 1 {
+			return errors.New("order line item quantity must be greater than 0")
+		}
+
+		if lineItem.Currency() != order.Currency() {
+			return errors.New("order line item currency " + lineItem.Currency() + " does not match the order currency " + order.Currency())
+		}
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		for _, lineItem := range lineItems {
+			reservation := NewStockReservation().
+				SetOrderID(order.ID()).
+				SetProductID(lineItem.ProductID()).
+				SetVariantID(lineItem.VariantID()).
+				SetQuantityInt(lineItem.QuantityInt())
+
+			if err := store.stockReservationInsert(txCtx, reservation); err != nil {
+				return err
+			}
+
+			if err := store.stockReservationConsume(txCtx, reservation); err != nil {
+				return err
+			}
+		}
+
+		if err := store.orderAddressesSnapshot(txCtx, order); err != nil {
+			return err
+		}
+
+		if err := store.OrderCreate(txCtx, order); err != nil {
+			return err
+		}
+
+		for _, lineItem := range lineItems {
+			lineItem.SetOrderID(order.ID())
+
+			if err := store.OrderLineItemCreate(txCtx, lineItem); err != nil {
+				return err
+			}
+		}
+
+		return store.orderRecalculate(txCtx, order, lineItems)
+	})
+}
+
+// orderAddressesSnapshot sets the billing and shipping addresses the order
+// does not have yet to a copy of the latest address of that type in the
+// address book of the customer. Orders without a customer are unchanged.
+func (store *Store) orderAddressesSnapshot(ctx context.Context, order OrderInterface) error {
+	if order.CustomerID() == "" {
+		return nil
+	}
+
+	if order.BillingAddress() == nil {
+		billing, err := store.customerAddressLatest(ctx, order.CustomerID(), ADDRESS_TYPE_BILLING)
+
+		if err != nil {
+			return err
+		}
+
+		order.SetBillingAddress(billing)
+	}
+
+	if order.ShippingAddress() == nil {
+		shipping, err := store.customerAddressLatest(ctx, order.CustomerID(), ADDRESS_TYPE_SHIPPING)
+
+		if err != nil {
+			return err
+		}
+
+		order.SetShippingAddress(shipping)
+	}
+
+	return nil
+}
+
+// customerAddressLatest returns the most recently created address of the
+// type in the address book of the customer, nil if there is none
+func (store *Store) customerAddressLatest(ctx context.Context, customerID string, addressType string) (AddressInterface, error) {
+	list, err := store.AddressList(ctx, NewAddressQuery().
+		SetCustomerID(customerID).
+		SetType(addressType).
+		SetOrderBy(COLUMN_CREATED_AT).
+		SetSortDirection(sb.DESC).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) < 1 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+// productStockDecrement checks the product can be sold and reduces its
+// quantity. The decrement is guarded in the WHERE clause, so concurrent
+// orders cannot take the quantity below zero.
+func (store *Store) productStockDecrement(ctx context.Context, productID string, quantity int64, orderID string) error {
+	product, err := store.ProductFindByID(ctx, productID)
+
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		return errors.New("product " + productID + " not found")
+	}
+
+	if !product.IsActive() {
+		return errors.New("product " + productID + " is not active")
+	}
+
+	if product.QuantityInt() < quantity {
+		return errors.New("product " + productID + " has insufficient stock")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productTableName).
+		Prepared(true).
+		Set(goqu.Record{
+			COLUMN_QUANTITY:   goqu.L("? - ?", goqu.C(COLUMN_QUANTITY), quantity),
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(productID)).
+		Where(goqu.C(COLUMN_QUANTITY).Gte(quantity)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	result, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected < 1 {
+		return errors.New("product " + productID + " has insufficient stock")
+	}
+
+	// The stock held for other orders cannot be sold
+	available, err := store.productAvailableQuantity(ctx, productID, orderID)
+
+	if err != nil {
+		return err
+	}
+
+	if available < 0 {
+		return errors.New("product " + productID + " has insufficient stock")
+	}
+
+	return nil
+}
+
+// productStockIncrement puts the quantity back into the stock of the
+// product, e.g. when the order it was taken for is cancelled
+func (store *Store) productStockIncrement(ctx context.Context, productID string, quantity int64) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productTableName).
+		Prepared(true).
+		Set(goqu.Record{
+			COLUMN_QUANTITY:   goqu.L("? + ?", goqu.C(COLUMN_QUANTITY), quantity),
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(productID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
@@ -0,0 +1,213 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStorePlaceOrder(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Ruler").
+		SetQuantityInt(5).
+		SetPriceFloat(19.99)
+
+	err = store.ProductCreate(ctx, product)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	lineItem := NewOrderLineItem().
+		SetProductID(product.ID()).
+		SetTitle(product.Title()).
+		SetQuantityInt(2).
+		SetPriceFloat(19.99)
+
+	err = store.PlaceOrder(ctx, order, []OrderLineItemInterface{lineItem})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound == nil {
+		t.Fatal("Order MUST NOT be nil")
+	}
+
+	lineItems, err := store.OrderLineItemList(ctx, NewOrderLineItemQuery().SetOrderID(order.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(lineItems) != 1 {
+		t.Fatal("Line items MUST be 1, found:", len(lineItems))
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 3 {
+		t.Fatal("Product quantity MUST be 3, found:", productFound.QuantityInt())
+	}
+}
+
+func TestStorePlaceOrderRollback(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	ruler := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Ruler").
+		SetQuantityInt(5)
+
+	pencil := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Pencil").
+		SetQuantityInt(1)
+
+	draft := NewProduct().
+		SetStatus(PRODUCT_STATUS_DRAFT).
+		SetTitle("Eraser").
+		SetQuantityInt(10)
+
+	for _, product := range []ProductInterface{ruler, pencil, draft} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	// not enough pencils in stock
+	order := NewOrder()
+
+	err = store.PlaceOrder(ctx, order, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(ruler.ID()).SetQuantityInt(2),
+		NewOrderLineItem().SetProductID(pencil.ID()).SetQuantityInt(2),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, pencil has insufficient stock")
+	}
+
+	// draft products cannot be ordered
+	err = store.PlaceOrder(ctx, NewOrder(), []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(draft.ID()).SetQuantityInt(1),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, eraser is not active")
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound != nil {
+		t.Fatal("Order MUST be nil, the transaction was rolled back")
+	}
+
+	rulerFound, err := store.ProductFindByID(ctx, ruler.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if rulerFound.QuantityInt() != 5 {
+		t.Fatal("Ruler quantity MUST still be 5, found:", rulerFound.QuantityInt())
+	}
+}
+
+func TestStorePlaceOrderAddressesSnapshot(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Ruler").
+		SetQuantityInt(5).
+		SetPriceFloat(19.99)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	billing := NewAddress().
+		SetCustomerID("CUSTOMER01_ID").
+		SetType(ADDRESS_TYPE_BILLING).
+		SetAddressLine1("1 High Street").
+		SetCity("London").
+		SetCountry("GB")
+
+	shipping := NewAddress().
+		SetCustomerID("CUSTOMER01_ID").
+		SetType(ADDRESS_TYPE_SHIPPING).
+		SetAddressLine1("2 Low Road").
+		SetCity("Leeds").
+		SetCountry("GB")
+
+	for _, address := range []AddressInterface{billing, shipping} {
+		if err := store.AddressCreate(ctx, address); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	// The shipping address set on the order is kept
+	order := NewOrder().
+		SetCustomerID("CUSTOMER01_ID").
+		SetShippingAddress(NewAddress().SetCity("York").SetCountry("GB"))
+
+	lineItem := NewOrderLineItem().
+		SetProductID(product.ID()).
+		SetTitle(product.Title()).
+		SetQuantityInt(1).
+		SetPriceFloat(19.99)
+
+	if err := store.PlaceOrder(ctx, order, []OrderLineItemInterface{lineItem}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.BillingAddress() == nil || orderFound.BillingAddress().City() != "London" {
+		t.Fatal("Order billing address MUST be the snapshot in London")
+	}
+
+	if orderFound.ShippingAddress() == nil || orderFound.ShippingAddress().City() != "York" {
+		t.Fatal("Order shipping address MUST be the one set in York")
+	}
+}
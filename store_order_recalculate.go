@@ -0,0 +1,202 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gouniverse/base/database"
+)
+
+// OrderRecalculate recomputes the totals of the order from its line items
+// and stores them on the order:
+//   - subtotal - the sum of the line item prices times their quantities
+//   - discount - the reduction of the discount applied to the order (see DiscountApply)
+//   - tax - the tax of the line items in the tax region of the order (see TaxCalculateLineItems),
+//     on the line item totals less their share of the discount
+//   - shipping - the shipping cost with the shipping method of the order (see ShippingQuote)
+//   - grand total - subtotal - discount + tax (not included in the prices) + shipping
+//
+// The legacy price and quantity of the order are set to the grand total
+// and to the total quantity of the line items, so they no longer drift.
+func (store *Store) OrderRecalculate(ctx context.Context, orderID string) (OrderInterface, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is empty")
+	}
+
+	var order OrderInterface
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		var err error
+		order, err = store.OrderFindByID(txCtx, orderID)
+
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return errors.New("order not found")
+		}
+
+		lineItems, err := store.OrderLineItemList(txCtx, NewOrderLineItemQuery().SetOrderID(orderID))
+
+		if err != nil {
+			return err
+		}
+
+		return store.orderRecalculate(txCtx, order, lineItems)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// orderRecalculate calculates and updates the totals of the order
+// from the given (already persisted) line items
+func (store *Store) orderRecalculate(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) error {
+	subtotal := NewMoney(0, order.Currency())
+	quantity := int64(0)
+
+	for _, lineItem := range lineItems {
+		if lineItem.Currency() != order.Currency() {
+			return errors.New("order line item currency " + lineItem.Currency() + " does not match the order currency " + order.Currency())
+		}
+
+		subtotal = subtotal.Add(lineItem.PriceMoney().Mul(lineItem.QuantityInt()))
+		quantity += lineItem.QuantityInt()
+	}
+
+	discount, err := store.orderCalculateDiscount(ctx, order, subtotal)
+
+	if err != nil {
+		return err
+	}
+
+	tax, taxExclusive, err := store.orderCalculateTax(ctx, order, lineItems, discount)
+
+	if err != nil {
+		return err
+	}
+
+	shipping, err := store.orderCalculateShipping(ctx, order, lineItems)
+
+	if err != nil {
+		return err
+	}
+
+	// Inclusive tax is already part of the subtotal
+	grandTotal := subtotal.Sub(discount).Add(taxExclusive).Add(shipping)
+
+	order.SetSubtotalMoney(subtotal)
+	order.SetDiscountTotalMoney(discount)
+	order.SetTaxTotalMoney(tax)
+	order.SetShippingTotalMoney(shipping)
+	order.SetGrandTotalMoney(grandTotal)
+	order.SetPriceMoney(grandTotal)
+	order.SetQuantityInt(quantity)
+
+	if order.Meta(ORDER_META_DISCOUNT_ID) != "" {
+		err := order.UpsertMetas(map[string]string{
+			ORDER_META_DISCOUNT_AMOUNT: discount.String(),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return store.OrderUpdate(ctx, order)
+}
+
+// orderCalculateDiscount returns the reduction of the discount applied to the
+// order on the subtotal. If the discount no longer exists there is no reduction.
+func (store *Store) orderCalculateDiscount(ctx context.Context, order OrderInterface, subtotal Money) (Money, error) {
+	discountID := order.Meta(ORDER_META_DISCOUNT_ID)
+
+	if discountID == "" {
+		return NewMoney(0, order.Currency()), nil
+	}
+
+	discount, err := store.DiscountFindByID(ctx, discountID)
+
+	if err != nil {
+		return NewMoney(0, order.Currency()), err
+	}
+
+	if discount == nil {
+		return NewMoney(0, order.Currency()), nil
+	}
+
+	if discount.Type() == DISCOUNT_TYPE_AMOUNT && discount.Currency() != order.Currency() {
+		return NewMoney(0, order.Currency()), errors.New("discount " + discount.Code() + " is in " + discount.Currency() + ", the order is in " + order.Currency())
+	}
+
+	return discountCalculateReduction(discount, subtotal), nil
+}
+
+// orderCalculateTax returns the total tax of the order after the discount
+// and the part of it that is not included in the prices. Orders without
+// a tax region keep the tax set on the order.
+func (store *Store) orderCalculateTax(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface, discount Money) (total Money, exclusive Money, err error) {
+	region := order.Meta(ORDER_META_TAX_REGION)
+
+	if region == "" {
+		return order.TaxTotalMoney(), order.TaxTotalMoney(), nil
+	}
+
+	total = NewMoney(0, order.Currency())
+	exclusive = NewMoney(0, order.Currency())
+
+	lineItemTaxes, err := store.taxCalculateLineItems(ctx, region, lineItems, discount)
+
+	if err != nil {
+		return total, exclusive, err
+	}
+
+	for _, lineItemTax := range lineItemTaxes {
+		total = total.Add(lineItemTax.Tax)
+
+		if !lineItemTax.IsInclusive() {
+			exclusive = exclusive.Add(lineItemTax.Tax)
+		}
+	}
+
+	return total, exclusive, nil
+}
+
+// orderCalculateShipping returns the shipping cost of the order with the
+// shipping method chosen for the order. Orders without a shipping method
+// keep the shipping cost set on the order.
+func (store *Store) orderCalculateShipping(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) (Money, error) {
+	shippingMethodID := order.Meta(ORDER_META_SHIPPING_METHOD_ID)
+
+	if shippingMethodID == "" {
+		return order.ShippingTotalMoney(), nil
+	}
+
+	shippingMethod, err := store.ShippingMethodFindByID(ctx, shippingMethodID)
+
+	if err != nil {
+		return NewMoney(0, order.Currency()), err
+	}
+
+	if shippingMethod == nil || !shippingMethod.IsActive() {
+		return NewMoney(0, order.Currency()), errors.New("shipping method " + shippingMethodID + " not found")
+	}
+
+	subtotal, weightGrams, err := store.shippingMeasure(ctx, order, lineItems)
+
+	if err != nil {
+		return NewMoney(0, order.Currency()), err
+	}
+
+	price, available := shippingMethod.Quote(subtotal, weightGrams)
+
+	if !available {
+		return NewMoney(0, order.Currency()), errors.New("shipping method " + shippingMethod.Title() + " cannot ship the order")
+	}
+
+	return price, nil
+}
@@ -0,0 +1,169 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreOrderRecalculate(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	discount := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_PERCENT).
+		SetAmount(10).
+		SetCode("TEN_PERCENT")
+
+	if err := store.DiscountCreate(ctx, discount); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().
+		SetCustomerID("CUSTOMER01_ID").
+		SetShippingTotalMoney(NewMoney(500, MONEY_CURRENCY_DEFAULT)).
+		SetPriceFloat(1000) // wrong on purpose, MUST be replaced
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT01_ID").SetPriceFloat(19.99).SetQuantityInt(2),
+		NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT02_ID").SetPriceFloat(0.1).SetQuantityInt(3),
+	}
+
+	for _, lineItem := range lineItems {
+		if err := store.OrderLineItemCreate(ctx, lineItem); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if _, err := store.DiscountApply(ctx, "TEN_PERCENT", order, lineItems); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderRecalculated, err := store.OrderRecalculate(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, o := range []OrderInterface{orderRecalculated, orderFound} {
+		// 2 x 19.99 + 3 x 0.10 = 40.28
+		if o.SubtotalMoney().Amount() != 4028 {
+			t.Fatal("Order subtotal MUST be 40.28, found:", o.Subtotal())
+		}
+
+		// 10% of 40.28 = 4.028
+		if o.DiscountTotalMoney().Amount() != 403 {
+			t.Fatal("Order discount total MUST be 4.03, found:", o.DiscountTotal())
+		}
+
+		if o.ShippingTotalMoney().Amount() != 500 {
+			t.Fatal("Order shipping total MUST be 5.00, found:", o.ShippingTotal())
+		}
+
+		if o.TaxTotalMoney().Amount() != 0 {
+			t.Fatal("Order tax total MUST be 0.00, found:", o.TaxTotal())
+		}
+
+		// 40.28 - 4.03 + 5.00 = 41.25
+		if o.GrandTotalMoney().Amount() != 4125 {
+			t.Fatal("Order grand total MUST be 41.25, found:", o.GrandTotal())
+		}
+
+		if o.PriceMoney().Amount() != 4125 {
+			t.Fatal("Order price MUST be 41.25, found:", o.Price())
+		}
+
+		if o.QuantityInt() != 5 {
+			t.Fatal("Order quantity MUST be 5, found:", o.QuantityInt())
+		}
+	}
+
+	if _, err := store.OrderRecalculate(ctx, "NOT_FOUND_ID"); err == nil {
+		t.Fatal("expected error, order does not exist")
+	}
+}
+
+func TestStoreOrderRecalculateTaxAfterDiscount(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	discount := NewDiscount().
+		SetStatus(DISCOUNT_STATUS_ACTIVE).
+		SetType(DISCOUNT_TYPE_AMOUNT).
+		SetAmount(15).
+		SetCode("FIFTEEN_OFF")
+
+	if err := store.DiscountCreate(ctx, discount); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	taxRate := NewTaxRate().
+		SetStatus(TAX_RATE_STATUS_ACTIVE).
+		SetRegion("US-NY").
+		SetRateFloat(10)
+
+	if err := store.TaxRateCreate(ctx, taxRate); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := order.UpsertMetas(map[string]string{ORDER_META_TAX_REGION: "US-NY"}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT01_ID").SetPriceFloat(100).SetQuantityInt(1),
+		NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT02_ID").SetPriceFloat(25).SetQuantityInt(2),
+	}
+
+	for _, lineItem := range lineItems {
+		if err := store.OrderLineItemCreate(ctx, lineItem); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if _, err := store.DiscountApply(ctx, "FIFTEEN_OFF", order, lineItems); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderRecalculated, err := store.OrderRecalculate(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// 10% of (150.00 - 15.00), not of 150.00
+	if orderRecalculated.TaxTotalMoney().Amount() != 1350 {
+		t.Fatal("Order tax total MUST be 13.50, found:", orderRecalculated.TaxTotal())
+	}
+
+	// 150.00 - 15.00 + 13.50 = 148.50
+	if orderRecalculated.GrandTotalMoney().Amount() != 14850 {
+		t.Fatal("Order grand total MUST be 148.50, found:", orderRecalculated.GrandTotal())
+	}
+}
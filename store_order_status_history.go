@@ -0,0 +1,123 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/samber/lo"
+)
+
+type orderStatusChangeKey struct{}
+
+type orderStatusChange struct {
+	actor string
+	note  string
+}
+
+// WithOrderStatusChange returns a copy of the context carrying who changed
+// the order status and why. OrderUpdate records both in the order status
+// history when it persists a status change with this context. If the
+// context is a database.QueryableContext (i.e. a transaction), so is
+// the copy, and the store keeps using the same transaction.
+func WithOrderStatusChange(ctx context.Context, actor string, note string) context.Context {
+	changeCtx := context.WithValue(ctx, orderStatusChangeKey{}, orderStatusChange{
+		actor: actor,
+		note:  note,
+	})
+
+	if queryableCtx, isQueryable := ctx.(database.QueryableContext); isQueryable {
+		return database.Context(changeCtx, queryableCtx.Queryable())
+	}
+
+	return changeCtx
+}
+
+func (store *Store) OrderStatusHistoryCreate(ctx context.Context, history OrderStatusHistoryInterface) error {
+	if history == nil {
+		return errors.New("order status history is nil")
+	}
+
+	if history.OrderID() == "" {
+		return errors.New("order status history order id is empty")
+	}
+
+	history.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	data := history.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.orderStatusHistoryTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	history.MarkAsNotDirty()
+
+	return nil
+}
+
+// OrderStatusHistoryList returns the status changes of the order, oldest first
+func (store *Store) OrderStatusHistoryList(ctx context.Context, orderID string) ([]OrderStatusHistoryInterface, error) {
+	if orderID == "" {
+		return []OrderStatusHistoryInterface{}, errors.New("order id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.orderStatusHistoryTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ORDER_ID).Eq(orderID)).
+		Order(goqu.I(COLUMN_CREATED_AT).Asc(), goqu.I(COLUMN_ID).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []OrderStatusHistoryInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []OrderStatusHistoryInterface{}, err
+	}
+
+	list := []OrderStatusHistoryInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewOrderStatusHistoryFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+// orderStatusHistoryRecord writes a status change of the order, taking
+// the actor and note from the context (see WithOrderStatusChange)
+func (store *Store) orderStatusHistoryRecord(ctx context.Context, orderID string, fromStatus string, toStatus string) error {
+	history := NewOrderStatusHistory().
+		SetOrderID(orderID).
+		SetFromStatus(fromStatus).
+		SetToStatus(toStatus)
+
+	if change, ok := ctx.Value(orderStatusChangeKey{}).(orderStatusChange); ok {
+		history.SetActor(change.actor)
+		history.SetNote(change.note)
+	}
+
+	return store.OrderStatusHistoryCreate(ctx, history)
+}
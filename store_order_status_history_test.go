@@ -0,0 +1,138 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gouniverse/base/database"
+)
+
+func TestStoreOrderStatusHistoryList(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_AWAITING_PAYMENT).
+		SetCustomerID("CUSTOMER01_ID")
+
+	err = store.OrderCreate(ctx, order)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// no status change, no history
+	order.SetMemo("memo updated")
+
+	err = store.OrderUpdate(ctx, order)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order.SetStatus(ORDER_STATUS_DISPUTED)
+
+	err = store.OrderUpdate(WithOrderStatusChange(ctx, "ADMIN01", "chargeback received"), order)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.OrderTransition(ctx, order, ORDER_STATUS_REFUNDED)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	history, err := store.OrderStatusHistoryList(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(history) != 2 {
+		t.Fatal("History MUST be 2, found:", len(history))
+	}
+
+	if history[0].FromStatus() != ORDER_STATUS_AWAITING_PAYMENT || history[0].ToStatus() != ORDER_STATUS_DISPUTED {
+		t.Fatal("First change MUST be awaiting_payment to disputed, found:", history[0].FromStatus(), history[0].ToStatus())
+	}
+
+	if history[0].Actor() != "ADMIN01" {
+		t.Fatal("First change actor MUST be ADMIN01, found:", history[0].Actor())
+	}
+
+	if history[0].Note() != "chargeback received" {
+		t.Fatal("First change note MUST be 'chargeback received', found:", history[0].Note())
+	}
+
+	if history[1].FromStatus() != ORDER_STATUS_DISPUTED || history[1].ToStatus() != ORDER_STATUS_REFUNDED {
+		t.Fatal("Second change MUST be disputed to refunded, found:", history[1].FromStatus(), history[1].ToStatus())
+	}
+
+	if history[1].Actor() != "" {
+		t.Fatal("Second change actor MUST be empty, found:", history[1].Actor())
+	}
+}
+
+func TestStoreOrderStatusHistoryInTransaction(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_AWAITING_PAYMENT).
+		SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	tx, err := store.DB().BeginTx(ctx, nil)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order.SetStatus(ORDER_STATUS_DISPUTED)
+
+	txCtx := WithOrderStatusChange(database.Context(ctx, tx), "ADMIN01", "chargeback received")
+
+	if err := store.OrderUpdate(txCtx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Rolled back with the transaction the status change was made in
+	if err := tx.Rollback(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_PAYMENT {
+		t.Fatal("Order status MUST be awaiting_payment after the rollback, found:", orderFound.Status())
+	}
+
+	history, err := store.OrderStatusHistoryList(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(history) != 0 {
+		t.Fatal("History MUST be empty after the rollback, found:", len(history))
+	}
+}
@@ -0,0 +1,56 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+// OrderStatusTransitionAllowed returns true if an order in the from status
+// may move to the to status. Staying in the same status is always allowed.
+func (store *Store) OrderStatusTransitionAllowed(from string, to string) bool {
+	if from == to {
+		return true
+	}
+
+	allowed, exists := store.orderStatusTransitions[from]
+
+	if !exists {
+		return false
+	}
+
+	return lo.Contains(allowed, to)
+}
+
+// OrderTransition moves the order to the new status and persists it.
+// If the move is not allowed by the order status transitions
+// an *OrderStatusTransitionError is returned and the order is left untouched.
+func (store *Store) OrderTransition(ctx context.Context, order OrderInterface, newStatus string) error {
+	if order == nil {
+		return errors.New("order is nil")
+	}
+
+	if newStatus == "" {
+		return errors.New("order status is empty")
+	}
+
+	if !store.OrderStatusTransitionAllowed(order.Status(), newStatus) {
+		return &OrderStatusTransitionError{
+			OrderID:    order.ID(),
+			FromStatus: order.Status(),
+			ToStatus:   newStatus,
+		}
+	}
+
+	previousStatus := order.Status()
+
+	order.SetStatus(newStatus)
+
+	if err := store.OrderUpdate(ctx, order); err != nil {
+		order.SetStatus(previousStatus)
+		return err
+	}
+
+	return nil
+}
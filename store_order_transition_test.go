@@ -0,0 +1,162 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestStoreOrderTransition(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_PENDING).
+		SetCustomerID("CUSTOMER01_ID")
+
+	err = store.OrderCreate(ctx, order)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.OrderTransition(ctx, order, ORDER_STATUS_AWAITING_PAYMENT)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_PAYMENT {
+		t.Fatal("Order status MUST be awaiting_payment, found:", orderFound.Status())
+	}
+
+	err = store.OrderTransition(ctx, order, ORDER_STATUS_COMPLETED)
+
+	if err == nil {
+		t.Fatal("expected error, awaiting_payment cannot move to completed")
+	}
+
+	var transitionErr *OrderStatusTransitionError
+
+	if !errors.As(err, &transitionErr) {
+		t.Fatal("expected OrderStatusTransitionError, found:", err)
+	}
+
+	if transitionErr.FromStatus != ORDER_STATUS_AWAITING_PAYMENT || transitionErr.ToStatus != ORDER_STATUS_COMPLETED {
+		t.Fatal("unexpected transition error:", transitionErr.Error())
+	}
+
+	if order.Status() != ORDER_STATUS_AWAITING_PAYMENT {
+		t.Fatal("Order status MUST be unchanged, found:", order.Status())
+	}
+}
+
+func TestStoreOrderTransitionCustomRules(t *testing.T) {
+	db, err := initDB(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	options := initStoreOptions(db)
+	options.OrderStatusTransitions = map[string][]string{
+		ORDER_STATUS_PENDING: {ORDER_STATUS_COMPLETED},
+	}
+
+	store, err := NewStore(options)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !store.OrderStatusTransitionAllowed(ORDER_STATUS_PENDING, ORDER_STATUS_COMPLETED) {
+		t.Fatal("pending MUST be allowed to move to completed")
+	}
+
+	if store.OrderStatusTransitionAllowed(ORDER_STATUS_PENDING, ORDER_STATUS_AWAITING_PAYMENT) {
+		t.Fatal("pending MUST NOT be allowed to move to awaiting_payment")
+	}
+
+	if store.OrderStatusTransitionAllowed(ORDER_STATUS_COMPLETED, ORDER_STATUS_REFUNDED) {
+		t.Fatal("completed MUST NOT be allowed to move anywhere")
+	}
+}
+
+func TestStoreOrderUpdateStatusTransition(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_PENDING).
+		SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order.SetStatus(ORDER_STATUS_COMPLETED)
+	order.SetMemo("Gift wrapped")
+
+	err = store.OrderUpdate(ctx, order)
+
+	var transitionErr *OrderStatusTransitionError
+
+	if !errors.As(err, &transitionErr) {
+		t.Fatal("expected OrderStatusTransitionError, pending cannot move to completed, found:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_PENDING {
+		t.Fatal("Order status MUST be pending, found:", orderFound.Status())
+	}
+
+	// The rejected order keeps the other changes to be retried
+	if order.Status() != ORDER_STATUS_PENDING {
+		t.Fatal("Order status MUST be restored to pending, found:", order.Status())
+	}
+
+	if _, memoChanged := order.DataChanged()[COLUMN_MEMO]; !memoChanged {
+		t.Fatal("Order memo MUST still be changed")
+	}
+
+	order.SetStatus(ORDER_STATUS_AWAITING_PAYMENT)
+
+	if err := store.OrderUpdate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err = store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_PAYMENT || orderFound.Memo() != "Gift wrapped" {
+		t.Fatal("Order MUST be awaiting payment with the memo, found:", orderFound.Status(), orderFound.Memo())
+	}
+
+	if len(order.DataChanged()) != 0 {
+		t.Fatal("Order MUST NOT be dirty after the update, found:", order.DataChanged())
+	}
+}
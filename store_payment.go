@@ -0,0 +1,382 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) PaymentCount(ctx context.Context, options PaymentQueryInterface) (int64, error) {
+	q, _, err := store.paymentQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+// PaymentCreate stores the payment. Once captured payments cover
+// the total of the order, the order moves to awaiting fulfillment.
+func (store *Store) PaymentCreate(ctx context.Context, payment PaymentInterface) error {
+	payment.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	payment.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	payment.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := payment.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.paymentTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		store.logSql("insert", sqlStr, params...)
+
+		_, err := database.Execute(txCtx, sqlStr, params...)
+
+		if err != nil {
+			return err
+		}
+
+		payment.MarkAsNotDirty()
+
+		return store.paymentOrderUpdate(txCtx, payment)
+	})
+}
+
+func (store *Store) PaymentDelete(ctx context.Context, payment PaymentInterface) error {
+	if payment == nil {
+		return errors.New("payment is nil")
+	}
+
+	return store.PaymentDeleteByID(ctx, payment.ID())
+}
+
+func (store *Store) PaymentDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("payment id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.paymentTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) PaymentFindByID(ctx context.Context, id string) (PaymentInterface, error) {
+	if id == "" {
+		return nil, errors.New("payment id is empty")
+	}
+
+	list, err := store.PaymentList(ctx, NewPaymentQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) PaymentList(ctx context.Context, options PaymentQueryInterface) ([]PaymentInterface, error) {
+	q, columns, err := store.paymentQuery(options)
+
+	if err != nil {
+		return []PaymentInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []PaymentInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []PaymentInterface{}, err
+	}
+
+	list := []PaymentInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewPaymentFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) PaymentSoftDelete(ctx context.Context, payment PaymentInterface) error {
+	if payment == nil {
+		return errors.New("payment is nil")
+	}
+
+	payment.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.PaymentUpdate(ctx, payment)
+}
+
+func (store *Store) PaymentSoftDeleteByID(ctx context.Context, id string) error {
+	payment, err := store.PaymentFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.PaymentSoftDelete(ctx, payment)
+}
+
+// PaymentUpdate updates the payment. Once captured payments cover
+// the total of the order, the order moves to awaiting fulfillment.
+func (store *Store) PaymentUpdate(ctx context.Context, payment PaymentInterface) error {
+	if payment == nil {
+		return errors.New("payment is nil")
+	}
+
+	payment.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := payment.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.paymentTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(payment.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		store.logSql("update", sqlStr, params...)
+
+		if _, err := database.Execute(txCtx, sqlStr, params...); err != nil {
+			return err
+		}
+
+		return store.paymentOrderUpdate(txCtx, payment)
+	})
+
+	payment.MarkAsNotDirty()
+
+	return err
+}
+
+// paymentCapturedTotal returns the total of the captured payments of the
+// order in the order currency, zero if none were captured
+func (store *Store) paymentCapturedTotal(ctx context.Context, order OrderInterface) (Money, error) {
+	captured := NewMoney(0, order.Currency())
+
+	payments, err := store.PaymentList(ctx, NewPaymentQuery().
+		SetOrderID(order.ID()))
+
+	if err != nil {
+		return captured, err
+	}
+
+	for _, payment := range payments {
+		if payment.IsCaptured() && payment.Currency() == order.Currency() {
+			captured = captured.Add(payment.AmountMoney())
+		}
+	}
+
+	return captured, nil
+}
+
+// paymentOrderUpdate moves the order of a captured payment, if pending or
+// awaiting payment, to awaiting fulfillment once its captured payments
+// cover its total
+func (store *Store) paymentOrderUpdate(ctx context.Context, payment PaymentInterface) error {
+	if !payment.IsCaptured() || payment.OrderID() == "" {
+		return nil
+	}
+
+	order, err := store.OrderFindByID(ctx, payment.OrderID())
+
+	if err != nil {
+		return err
+	}
+
+	if order == nil {
+		return nil
+	}
+
+	if !order.IsPending() && !order.IsAwaitingPayment() {
+		return nil
+	}
+
+	if !store.OrderStatusTransitionAllowed(order.Status(), ORDER_STATUS_AWAITING_FULFILLMENT) {
+		return nil
+	}
+
+	captured, err := store.paymentCapturedTotal(ctx, order)
+
+	if err != nil {
+		return err
+	}
+
+	if !captured.IsPositive() || captured.Cmp(orderTotal(order)) < 0 {
+		return nil
+	}
+
+	return store.OrderTransition(ctx, order, ORDER_STATUS_AWAITING_FULFILLMENT)
+}
+
+// orderTotal returns the total to be paid for the order, its grand total.
+// Orders placed before the totals were stored only have a price.
+func orderTotal(order OrderInterface) Money {
+	total := order.GrandTotalMoney()
+
+	if total.IsZero() {
+		return order.PriceMoney()
+	}
+
+	return total
+}
+
+func (store *Store) paymentQuery(options PaymentQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewPaymentQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.paymentTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasIDIn() {
+		q = q.Where(goqu.C(COLUMN_ID).In(options.IDIn()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if options.HasStatusIn() {
+		q = q.Where(goqu.C(COLUMN_STATUS).In(options.StatusIn()))
+	}
+
+	if options.HasOrderID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Eq(options.OrderID()))
+	}
+
+	if options.HasProvider() {
+		q = q.Where(goqu.C(COLUMN_PROVIDER).Eq(options.Provider()))
+	}
+
+	if options.HasProviderReference() {
+		q = q.Where(goqu.C(COLUMN_PROVIDER_REFERENCE).Eq(options.ProviderReference()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted payments requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
@@ -0,0 +1,192 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStorePaymentCreateAndFind(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	payment := NewPayment().
+		SetOrderID("ORDER01_ID").
+		SetProvider("stripe").
+		SetProviderReference("pi_123").
+		SetAmountMoney(NewMoney(1999, "EUR"))
+
+	if err := payment.SetMeta(PAYMENT_META_RAW_PAYLOAD, `{"id":"pi_123"}`); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.PaymentCreate(ctx, payment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	list, err := store.PaymentList(ctx, NewPaymentQuery().
+		SetProvider("stripe").
+		SetProviderReference("pi_123"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatal("Payment list MUST have 1 item, found:", len(list))
+	}
+
+	if list[0].AmountMoney().Amount() != 1999 || list[0].Currency() != "EUR" {
+		t.Fatal("Payment amount MUST be 19.99 EUR, found:", list[0].Amount(), list[0].Currency())
+	}
+
+	if list[0].Meta(PAYMENT_META_RAW_PAYLOAD) != `{"id":"pi_123"}` {
+		t.Fatal("Payment raw payload MUST be stored, found:", list[0].Meta(PAYMENT_META_RAW_PAYLOAD))
+	}
+
+	if err := store.PaymentSoftDeleteByID(ctx, payment.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	count, err := store.PaymentCount(ctx, NewPaymentQuery().SetOrderID("ORDER01_ID"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 0 {
+		t.Fatal("Payment count MUST be 0 after soft delete, found:", count)
+	}
+}
+
+func TestStorePaymentCapturedMovesOrderToAwaitingFulfillment(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_AWAITING_PAYMENT).
+		SetCustomerID("CUSTOMER01_ID").
+		SetGrandTotalMoney(NewMoney(3000, "USD"))
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	first := NewPayment().
+		SetOrderID(order.ID()).
+		SetProvider("stripe").
+		SetAmountMoney(NewMoney(1000, "USD")).
+		SetStatus(PAYMENT_STATUS_CAPTURED)
+
+	second := NewPayment().
+		SetOrderID(order.ID()).
+		SetProvider("stripe").
+		SetAmountMoney(NewMoney(2000, "USD")).
+		SetStatus(PAYMENT_STATUS_AUTHORIZED)
+
+	for _, payment := range []PaymentInterface{first, second} {
+		if err := store.PaymentCreate(ctx, payment); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_PAYMENT {
+		t.Fatal("Order status MUST be awaiting_payment, only 10.00 is captured, found:", orderFound.Status())
+	}
+
+	second.SetStatus(PAYMENT_STATUS_CAPTURED)
+
+	if err := store.PaymentUpdate(ctx, second); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err = store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_FULFILLMENT {
+		t.Fatal("Order status MUST be awaiting_fulfillment, found:", orderFound.Status())
+	}
+
+	history, err := store.OrderStatusHistoryList(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(history) != 1 || history[0].ToStatus() != ORDER_STATUS_AWAITING_FULFILLMENT {
+		t.Fatal("Order status history MUST record the move to awaiting_fulfillment, found:", len(history))
+	}
+
+	// Refunds are limited to the captured payments
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetAmountMoney(NewMoney(3001, "USD")))
+
+	if err == nil {
+		t.Fatal("expected error, the refund exceeds the captured payments")
+	}
+}
+
+func TestStoreOrderUpdateKeepsStatusSetWithCapturedPayments(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_MANUAL_VERIFICATION_REQUIRED).
+		SetCustomerID("CUSTOMER01_ID").
+		SetGrandTotalMoney(NewMoney(3000, "USD"))
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	payment := NewPayment().
+		SetOrderID(order.ID()).
+		SetProvider("stripe").
+		SetAmountMoney(NewMoney(3000, "USD")).
+		SetStatus(PAYMENT_STATUS_CAPTURED)
+
+	if err := store.PaymentCreate(ctx, payment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The status set by hand is kept, only capturing payments moves it on
+	order.SetStatus(ORDER_STATUS_AWAITING_PAYMENT)
+
+	if err := store.OrderUpdate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_AWAITING_PAYMENT {
+		t.Fatal("Order status MUST be awaiting_payment, found:", orderFound.Status())
+	}
+}
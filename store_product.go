@@ -3,7 +3,6 @@ package shopstore
 import (
 	"context"
 	"errors"
-	"log"
 	"strconv"
 	"strings"
 
@@ -17,7 +16,7 @@ import (
 )
 
 func (store *Store) ProductCount(ctx context.Context, options ProductQueryInterface) (int64, error) {
-	q, _, err := store.productQuery(options.SetCountOnly(true))
+	q, _, err := store.productQuery(ctx, options.SetCountOnly(true))
 
 	if err != nil {
 		return -1, err
@@ -56,6 +55,8 @@ func (store *Store) ProductCount(ctx context.Context, options ProductQueryInterf
 	return i, nil
 }
 
+// ProductCreate creates the product with a unique slug, the slug set or
+// else the slugified title, suffixed with a number if already taken
 func (store *Store) ProductCreate(ctx context.Context, product ProductInterface) error {
 	if product == nil {
 		return errors.New("product is nil")
@@ -65,29 +66,39 @@ func (store *Store) ProductCreate(ctx context.Context, product ProductInterface)
 	product.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
 	product.SetSoftDeletedAt(sb.MAX_DATETIME)
 
-	data := product.Data()
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		slug, err := store.slugUnique(txCtx, store.productTableName, product.ID(), product.Slug())
 
-	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
-		Insert(store.productTableName).
-		Prepared(true).
-		Rows(data).
-		ToSQL()
+		if err != nil {
+			return err
+		}
 
-	if errSql != nil {
-		return errSql
-	}
+		product.SetSlug(slug)
 
-	store.logSql("insert", sqlStr, params...)
+		data := product.Data()
 
-	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Insert(store.productTableName).
+			Prepared(true).
+			Rows(productRecord(data)).
+			ToSQL()
 
-	if err != nil {
-		return err
-	}
+		if errSql != nil {
+			return errSql
+		}
 
-	product.MarkAsNotDirty()
+		store.logSql("insert", sqlStr, params...)
 
-	return nil
+		_, err = database.Execute(store.toQuerableContext(txCtx), sqlStr, params...)
+
+		if err != nil {
+			return err
+		}
+
+		product.MarkAsNotDirty()
+
+		return nil
+	})
 }
 
 func (store *Store) ProductDelete(ctx context.Context, product ProductInterface) error {
@@ -98,35 +109,70 @@ func (store *Store) ProductDelete(ctx context.Context, product ProductInterface)
 	return store.ProductDeleteByID(ctx, product.ID())
 }
 
+// ProductDeleteByID deletes the product together with the rows it owns:
+// its attributes, categories, prices, options with their values, variants
+// and slug redirects. The orders, carts and stock reservations of the
+// product are kept.
 func (store *Store) ProductDeleteByID(ctx context.Context, id string) error {
 	if id == "" {
 		return errors.New("product id is empty")
 	}
 
-	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
-		Delete(store.productTableName).
-		Prepared(true).
-		Where(goqu.C(COLUMN_ID).Eq(id)).
-		ToSQL()
-
-	if errSql != nil {
-		return errSql
-	}
-
-	if store.debugEnabled {
-		log.Println(sqlStr)
-	}
-
-	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+	productOptionIDs := goqu.Dialect(store.dbDriverName).
+		From(store.productOptionTableName).
+		Select(COLUMN_ID).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(id))
+
+	// The option values before the options they belong to
+	owned := []struct {
+		tableName string
+		where     goqu.Expression
+	}{
+		{store.productAttributeTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(id)},
+		{store.productCategoryTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(id)},
+		{store.productPriceTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(id)},
+		{store.productOptionValueTableName, goqu.C(COLUMN_OPTION_ID).In(productOptionIDs)},
+		{store.productOptionTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(id)},
+		{store.productVariantTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(id)},
+		{store.slugRedirectTableName, goqu.Ex{
+			COLUMN_ENTITY_TYPE: SLUG_REDIRECT_ENTITY_TYPE_PRODUCT,
+			COLUMN_ENTITY_ID:   id,
+		}},
+		{store.productTableName, goqu.C(COLUMN_ID).Eq(id)},
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		for _, rows := range owned {
+			sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+				Delete(rows.tableName).
+				Prepared(true).
+				Where(rows.where).
+				ToSQL()
+
+			if errSql != nil {
+				return errSql
+			}
+
+			store.logSql("delete", sqlStr, params...)
+
+			if _, err := database.Execute(txCtx, sqlStr, params...); err != nil {
+				return err
+			}
+		}
 
-	return err
+		return nil
+	})
 }
 
+// ProductSoftDelete marks the product as deleted. Its SKU and barcode are
+// cleared, so they can be given to another product.
 func (store *Store) ProductSoftDelete(ctx context.Context, product ProductInterface) error {
 	if product == nil {
 		return errors.New("product is empty")
 	}
 
+	product.SetSKU("")
+	product.SetBarcode("")
 	product.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
 
 	return store.ProductUpdate(ctx, product)
@@ -162,8 +208,86 @@ func (store *Store) ProductFindByID(ctx context.Context, id string) (ProductInte
 	return nil, nil
 }
 
+// ProductFindByBarcode returns the product with the barcode,
+// or nil if there is no such product
+func (store *Store) ProductFindByBarcode(ctx context.Context, barcode string) (ProductInterface, error) {
+	if barcode == "" {
+		return nil, errors.New("product barcode is empty")
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetBarcode(barcode).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+// ProductFindBySKU returns the product with the SKU,
+// or nil if there is no such product
+func (store *Store) ProductFindBySKU(ctx context.Context, sku string) (ProductInterface, error) {
+	if sku == "" {
+		return nil, errors.New("product sku is empty")
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetSKU(sku).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+// ProductFindBySlug returns the product with the slug, or the product the
+// slug belonged to before its slug was changed. In the latter case the
+// slug of the product differs from the one looked for, so callers can
+// redirect to the current one. Returns nil if there is no such product.
+func (store *Store) ProductFindBySlug(ctx context.Context, slug string) (ProductInterface, error) {
+	if slug == "" {
+		return nil, errors.New("product slug is empty")
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetSlug(slug).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	productID, err := store.slugRedirectEntityID(ctx, SLUG_REDIRECT_ENTITY_TYPE_PRODUCT, slug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if productID == "" {
+		return nil, nil
+	}
+
+	return store.ProductFindByID(ctx, productID)
+}
+
 func (store *Store) ProductList(ctx context.Context, options ProductQueryInterface) ([]ProductInterface, error) {
-	q, columns, err := store.productQuery(options)
+	q, columns, err := store.productQuery(ctx, options)
 
 	if err != nil {
 		return []ProductInterface{}, err
@@ -192,6 +316,9 @@ func (store *Store) ProductList(ctx context.Context, options ProductQueryInterfa
 	return list, nil
 }
 
+// ProductUpdate updates the changed data of the product. A changed slug
+// is made unique, and the previous slug kept to redirect from
+// (see ProductFindBySlug). Changing the title does not change the slug.
 func (store *Store) ProductUpdate(ctx context.Context, product ProductInterface) error {
 	if product == nil {
 		return errors.New("product is nil")
@@ -209,11 +336,49 @@ func (store *Store) ProductUpdate(ctx context.Context, product ProductInterface)
 		return nil
 	}
 
+	if _, slugChanged := dataChanged[COLUMN_SLUG]; !slugChanged {
+		if err := store.productUpdateData(ctx, product.ID(), dataChanged); err != nil {
+			return err
+		}
+
+		product.MarkAsNotDirty()
+
+		return nil
+	}
+
+	// slug changes are recorded in the slug redirects,
+	// together with the update in one transaction
+	requestedSlug := product.Slug()
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		slug, err := store.slugChange(txCtx, store.productTableName, SLUG_REDIRECT_ENTITY_TYPE_PRODUCT, product.ID(), requestedSlug)
+
+		if err != nil {
+			return err
+		}
+
+		dataChanged[COLUMN_SLUG] = slug
+
+		return store.productUpdateData(txCtx, product.ID(), dataChanged)
+	})
+
+	// The product keeps its changes, so the update can be retried
+	if err != nil {
+		return err
+	}
+
+	product.SetSlug(dataChanged[COLUMN_SLUG])
+	product.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) productUpdateData(ctx context.Context, productID string, dataChanged map[string]string) error {
 	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
 		Update(store.productTableName).
 		Prepared(true).
-		Set(dataChanged).
-		Where(goqu.C(COLUMN_ID).Eq(product.ID())).
+		Set(productRecord(dataChanged)).
+		Where(goqu.C(COLUMN_ID).Eq(productID)).
 		ToSQL()
 
 	if errSql != nil {
@@ -224,12 +389,10 @@ func (store *Store) ProductUpdate(ctx context.Context, product ProductInterface)
 
 	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
 
-	product.MarkAsNotDirty()
-
 	return err
 }
 
-func (store *Store) productQuery(options ProductQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+func (store *Store) productQuery(ctx context.Context, options ProductQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
 	if options == nil {
 		return nil, nil, errors.New("product options cannot be nil")
 	}
@@ -248,10 +411,74 @@ func (store *Store) productQuery(options ProductQueryInterface) (selectDataset *
 		q = q.Where(goqu.C(COLUMN_ID).In(options.IDIn()))
 	}
 
+	if options.HasSKU() {
+		q = q.Where(goqu.C(COLUMN_SKU).Eq(options.SKU()))
+	}
+
+	if options.HasSKUIn() {
+		q = q.Where(goqu.C(COLUMN_SKU).In(options.SKUIn()))
+	}
+
+	if options.HasSlug() {
+		q = q.Where(goqu.C(COLUMN_SLUG).Eq(options.Slug()))
+	}
+
+	if options.HasBarcode() {
+		q = q.Where(goqu.C(COLUMN_BARCODE).Eq(options.Barcode()))
+	}
+
 	if options.HasTitleLike() {
 		q = q.Where(goqu.C(COLUMN_TITLE).ILike(`%` + options.TitleLike() + `%`))
 	}
 
+	if options.HasSearch() {
+		ranked := !options.HasOrderBy() && !options.IsCountOnly()
+		q = store.productSearch(q, options.Search(), ranked)
+	}
+
+	if options.HasCategoryID() || options.HasCategoryIDIn() {
+		categoryIDs := options.CategoryIDIn()
+
+		if options.HasCategoryID() {
+			categoryIDs = append([]string{options.CategoryID()}, categoryIDs...)
+		}
+
+		if options.CategoryDescendantsIncluded() {
+			categoryIDs, err = store.productCategoryIDsWithDescendants(ctx, categoryIDs)
+
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		productIDs := goqu.Dialect(store.dbDriverName).
+			From(store.productCategoryTableName).
+			Select(COLUMN_PRODUCT_ID).
+			Where(goqu.C(COLUMN_CATEGORY_ID).In(categoryIDs))
+
+		q = q.Where(goqu.C(COLUMN_ID).In(productIDs))
+	}
+
+	if options.HasAttributeValues() || options.HasAttributeGte() || options.HasAttributeLte() {
+		q = store.productAttributeFilter(q, options)
+	}
+
+	if options.HasPriceGte() {
+		q = q.Where(store.productPriceFilter(options.PriceGte(), func(price exp.IdentifierExpression, amount float64) exp.Expression {
+			return price.Gte(amount)
+		}))
+	}
+
+	if options.HasPriceLte() {
+		q = q.Where(store.productPriceFilter(options.PriceLte(), func(price exp.IdentifierExpression, amount float64) exp.Expression {
+			return price.Lte(amount)
+		}))
+	}
+
+	if options.HasInStock() {
+		q = q.Where(store.productInStockFilter(options.InStock()))
+	}
+
 	if options.HasStatus() {
 		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
 	}
@@ -303,3 +530,22 @@ func (store *Store) productQuery(options ProductQueryInterface) (selectDataset *
 
 	return q.Where(softDeleted), columns, nil
 }
+
+// productRecord returns the product data to be stored, with empty SKUs and
+// barcodes as NULL, so the products without them do not break the unique
+// constraints of the columns
+func productRecord(data map[string]string) goqu.Record {
+	record := goqu.Record{}
+
+	for column, value := range data {
+		record[column] = value
+	}
+
+	for _, column := range []string{COLUMN_SKU, COLUMN_BARCODE} {
+		if value, exists := data[column]; exists && value == "" {
+			record[column] = nil
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,312 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+// ProductAttributeCreate adds the attribute to its product
+func (store *Store) ProductAttributeCreate(ctx context.Context, attribute ProductAttributeInterface) error {
+	if attribute == nil {
+		return errors.New("product attribute is nil")
+	}
+
+	if attribute.ProductID() == "" {
+		return errors.New("product attribute product id is empty")
+	}
+
+	if err := productAttributeValidate(attribute); err != nil {
+		return err
+	}
+
+	attribute.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	attribute.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.productAttributeTableName).
+		Prepared(true).
+		Rows(productAttributeRecord(attribute.Data())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	attribute.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) ProductAttributeDelete(ctx context.Context, attribute ProductAttributeInterface) error {
+	if attribute == nil {
+		return errors.New("product attribute is nil")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.productAttributeTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(attribute.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// ProductAttributeFacets returns how many of the products matched by the
+// options have each of the attribute values, of the attributes named or
+// else all of them, by name and then the most products first. Pagination
+// is ignored. Only the values of the matched products are counted, so to
+// offer the other values of an attribute filtered by, count with options
+// not filtering by it.
+func (store *Store) ProductAttributeFacets(ctx context.Context, options ProductQueryInterface, names ...string) ([]ProductAttributeFacet, error) {
+	if options == nil {
+		return []ProductAttributeFacet{}, errors.New("product options cannot be nil")
+	}
+
+	countOnly := options.IsCountOnly()
+
+	products, _, err := store.productQuery(ctx, options.SetCountOnly(true))
+
+	options.SetCountOnly(countOnly)
+
+	if err != nil {
+		return []ProductAttributeFacet{}, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).
+		From(store.productAttributeTableName).
+		Prepared(true).
+		Select(
+			goqu.C(COLUMN_NAME),
+			goqu.C(COLUMN_VALUE),
+			goqu.COUNT(goqu.DISTINCT(COLUMN_PRODUCT_ID)).As("count"),
+		).
+		Where(goqu.C(COLUMN_PRODUCT_ID).In(products.Select(COLUMN_ID))).
+		GroupBy(COLUMN_NAME, COLUMN_VALUE).
+		Order(goqu.C(COLUMN_NAME).Asc(), goqu.I("count").Desc(), goqu.C(COLUMN_VALUE).Asc())
+
+	if len(names) > 0 {
+		q = q.Where(goqu.C(COLUMN_NAME).In(names))
+	}
+
+	sqlStr, params, errSql := q.ToSQL()
+
+	if errSql != nil {
+		return []ProductAttributeFacet{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []ProductAttributeFacet{}, err
+	}
+
+	facets := lo.Map(mapped, func(row map[string]string, index int) ProductAttributeFacet {
+		return ProductAttributeFacet{
+			Name:  row[COLUMN_NAME],
+			Value: row[COLUMN_VALUE],
+			Count: cast.ToInt64(row["count"]),
+		}
+	})
+
+	return facets, nil
+}
+
+func (store *Store) ProductAttributeFindByID(ctx context.Context, id string) (ProductAttributeInterface, error) {
+	if id == "" {
+		return nil, errors.New("product attribute id is empty")
+	}
+
+	list, err := store.productAttributeSelect(ctx, goqu.C(COLUMN_ID).Eq(id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+// ProductAttributeList returns the attributes of the product by name
+func (store *Store) ProductAttributeList(ctx context.Context, productID string) ([]ProductAttributeInterface, error) {
+	if productID == "" {
+		return []ProductAttributeInterface{}, errors.New("product id is empty")
+	}
+
+	return store.productAttributeSelect(ctx, goqu.C(COLUMN_PRODUCT_ID).Eq(productID))
+}
+
+func (store *Store) ProductAttributeUpdate(ctx context.Context, attribute ProductAttributeInterface) error {
+	if attribute == nil {
+		return errors.New("product attribute is nil")
+	}
+
+	if err := productAttributeValidate(attribute); err != nil {
+		return err
+	}
+
+	attribute.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	dataChanged := attribute.DataChanged()
+
+	delete(dataChanged, COLUMN_ID)         // ID is not updateable
+	delete(dataChanged, COLUMN_PRODUCT_ID) // Product is not updateable
+	delete(dataChanged, "hash")            // Hash is not updateable
+	delete(dataChanged, "data")            // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productAttributeTableName).
+		Prepared(true).
+		Set(productAttributeRecord(dataChanged)).
+		Where(goqu.C(COLUMN_ID).Eq(attribute.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	attribute.MarkAsNotDirty()
+
+	return err
+}
+
+// productAttributeFilter filters the products to the ones with the attribute
+// values and within the attribute ranges of the options, every attribute
+// named must match
+func (store *Store) productAttributeFilter(q *goqu.SelectDataset, options ProductQueryInterface) *goqu.SelectDataset {
+	productIDs := func(name string, where goqu.Expression) *goqu.SelectDataset {
+		return goqu.Dialect(store.dbDriverName).
+			From(store.productAttributeTableName).
+			Select(COLUMN_PRODUCT_ID).
+			Where(goqu.C(COLUMN_NAME).Eq(name), where)
+	}
+
+	attributeValues := options.AttributeValues()
+
+	for _, name := range productAttributeNames(attributeValues) {
+		q = q.Where(goqu.C(COLUMN_ID).In(productIDs(name, goqu.C(COLUMN_VALUE).In(attributeValues[name]))))
+	}
+
+	attributeGte := options.AttributeGte()
+
+	for _, name := range productAttributeNames(attributeGte) {
+		q = q.Where(goqu.C(COLUMN_ID).In(productIDs(name, goqu.C(COLUMN_VALUE_NUMERIC).Gte(attributeGte[name]))))
+	}
+
+	attributeLte := options.AttributeLte()
+
+	for _, name := range productAttributeNames(attributeLte) {
+		q = q.Where(goqu.C(COLUMN_ID).In(productIDs(name, goqu.C(COLUMN_VALUE_NUMERIC).Lte(attributeLte[name]))))
+	}
+
+	return q
+}
+
+func (store *Store) productAttributeSelect(ctx context.Context, where goqu.Expression) ([]ProductAttributeInterface, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.productAttributeTableName).
+		Prepared(true).
+		Where(where).
+		Order(goqu.I(COLUMN_NAME).Asc(), goqu.I(COLUMN_VALUE).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []ProductAttributeInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []ProductAttributeInterface{}, err
+	}
+
+	list := lo.Map(modelMaps, func(modelMap map[string]string, index int) ProductAttributeInterface {
+		return NewProductAttributeFromExistingData(modelMap)
+	})
+
+	return list, nil
+}
+
+// productAttributeNames returns the attribute names of the filter sorted,
+// so the same filter always builds the same SQL
+func productAttributeNames[T any](filter map[string]T) []string {
+	names := lo.Keys(filter)
+
+	sort.Strings(names)
+
+	return names
+}
+
+// productAttributeRecord returns the attribute data to be stored,
+// with an empty numeric value as NULL
+func productAttributeRecord(data map[string]string) goqu.Record {
+	record := goqu.Record{}
+
+	for column, value := range data {
+		record[column] = value
+	}
+
+	if value, exists := data[COLUMN_VALUE_NUMERIC]; exists && value == "" {
+		record[COLUMN_VALUE_NUMERIC] = nil
+	}
+
+	return record
+}
+
+func productAttributeValidate(attribute ProductAttributeInterface) error {
+	if attribute.Name() == "" {
+		return errors.New("product attribute name is empty")
+	}
+
+	if attribute.Value() == "" {
+		return errors.New("product attribute value is empty")
+	}
+
+	if !attribute.HasValueNumeric() {
+		return nil
+	}
+
+	if _, err := strconv.ParseFloat(attribute.ValueNumeric(), 64); err != nil {
+		return errors.New("product attribute numeric value " + attribute.ValueNumeric() + " is not a number")
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductAttributeFilterAndFacets(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	redShirt := NewProduct().SetTitle("Red Shirt").SetPriceFloat(20).SetQuantityInt(5)
+	blueShirt := NewProduct().SetTitle("Blue Shirt").SetPriceFloat(45).SetQuantityInt(0)
+	redMug := NewProduct().SetTitle("Red Mug").SetPriceFloat(60).SetQuantityInt(3)
+
+	attributes := map[ProductInterface][]ProductAttributeInterface{
+		redShirt: {
+			NewProductAttribute().SetName("colour").SetValue("red"),
+			NewProductAttribute().SetName("weight").SetValueNumericFloat(0.2),
+		},
+		blueShirt: {
+			NewProductAttribute().SetName("colour").SetValue("blue"),
+			NewProductAttribute().SetName("weight").SetValueNumericFloat(0.25),
+		},
+		redMug: {
+			NewProductAttribute().SetName("colour").SetValue("red"),
+			NewProductAttribute().SetName("weight").SetValueNumericFloat(0.4),
+		},
+	}
+
+	for product, productAttributes := range attributes {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		for _, attribute := range productAttributes {
+			if err := store.ProductAttributeCreate(ctx, attribute.SetProductID(product.ID())); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+		}
+	}
+
+	invalid := NewProductAttribute().
+		SetProductID(redMug.ID()).
+		SetName("height").
+		SetValue("tall").
+		SetValueNumeric("tall")
+
+	if err := store.ProductAttributeCreate(ctx, invalid); err == nil {
+		t.Fatal("Product attribute with a numeric value not a number MUST fail")
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetAttributeValues(map[string][]string{"colour": {"red"}}).
+		SetPriceGte(NewMoney(1000, "USD")).
+		SetPriceLte(NewMoney(5000, "USD")).
+		SetInStock(true))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].ID() != redShirt.ID() {
+		t.Fatal("Products found MUST be the red shirt, found:", len(list))
+	}
+
+	// Prices in other currencies are filtered by the prices set for them
+	if err := store.ProductPriceSet(ctx, redMug.ID(), NewMoney(3000, "EUR")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductPriceSet(ctx, redShirt.ID(), NewMoney(1000, "USD")); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	list, err = store.ProductList(ctx, NewProductQuery().
+		SetPriceGte(NewMoney(1000, "EUR")).
+		SetPriceLte(NewMoney(5000, "EUR")))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].ID() != redMug.ID() {
+		t.Fatal("Products priced 10 to 50 EUR MUST be the red mug, found:", len(list))
+	}
+
+	count, err := store.ProductCount(ctx, NewProductQuery().
+		SetPriceLte(NewMoney(1500, "USD")))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Products priced up to 15 USD MUST be the red shirt, by its USD price, found:", count)
+	}
+
+	count, err = store.ProductCount(ctx, NewProductQuery().
+		SetAttributeGte(map[string]float64{"weight": 0.2}).
+		SetAttributeLte(map[string]float64{"weight": 0.3}))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 2 {
+		t.Fatal("Products weighing 0.2 to 0.3 MUST be 2, found:", count)
+	}
+
+	facets, err := store.ProductAttributeFacets(ctx, NewProductQuery().SetLimit(1), "colour")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := []ProductAttributeFacet{
+		{Name: "colour", Value: "red", Count: 2},
+		{Name: "colour", Value: "blue", Count: 1},
+	}
+
+	if len(facets) != len(expected) {
+		t.Fatal("Facets MUST be", expected, "found:", facets)
+	}
+
+	for i, facet := range facets {
+		if facet != expected[i] {
+			t.Fatal("Facets MUST be", expected, "found:", facets)
+		}
+	}
+
+	facets, err = store.ProductAttributeFacets(ctx, NewProductQuery().SetInStock(true))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(facets) != 3 || facets[0] != (ProductAttributeFacet{Name: "colour", Value: "red", Count: 2}) {
+		t.Fatal("Facets of the products in stock MUST be colour red and two weights, found:", facets)
+	}
+
+	weights, err := store.ProductAttributeList(ctx, blueShirt.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(weights) != 2 || weights[1].Name() != "weight" || weights[1].ValueNumericFloat() != 0.25 {
+		t.Fatal("Blue shirt MUST weigh 0.25, found:", weights)
+	}
+
+	if weights[0].HasValueNumeric() {
+		t.Fatal("Blue shirt colour MUST NOT have a numeric value, found:", weights[0].ValueNumeric())
+	}
+}
+
+func TestStoreProductAttributeDeletedWithProduct(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().SetTitle("Red Shirt")
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	attribute := NewProductAttribute().
+		SetProductID(product.ID()).
+		SetName("colour").
+		SetValue("red")
+
+	if err := store.ProductAttributeCreate(ctx, attribute); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductDelete(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	attributeFound, err := store.ProductAttributeFindByID(ctx, attribute.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if attributeFound != nil {
+		t.Fatal("Product attribute MUST be deleted with its product")
+	}
+}
@@ -0,0 +1,133 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/uid"
+	"github.com/samber/lo"
+)
+
+// ProductCategoryAdd assigns the product to the category.
+// Adding an already existing assignment is a no-op. The assignments are
+// unique, of concurrent adds of the same assignment all but one fail.
+func (store *Store) ProductCategoryAdd(ctx context.Context, productID string, categoryID string) error {
+	if productID == "" {
+		return errors.New("product id is empty")
+	}
+
+	if categoryID == "" {
+		return errors.New("category id is empty")
+	}
+
+	categoryIDs, err := store.ProductCategoryList(ctx, productID)
+
+	if err != nil {
+		return err
+	}
+
+	if lo.Contains(categoryIDs, categoryID) {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.productCategoryTableName).
+		Prepared(true).
+		Rows(map[string]string{
+			COLUMN_ID:          uid.HumanUid(),
+			COLUMN_PRODUCT_ID:  productID,
+			COLUMN_CATEGORY_ID: categoryID,
+			COLUMN_CREATED_AT:  carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err = database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// ProductCategoryList returns the IDs of the categories the product is assigned to
+func (store *Store) ProductCategoryList(ctx context.Context, productID string) ([]string, error) {
+	if productID == "" {
+		return []string{}, errors.New("product id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.productCategoryTableName).
+		Prepared(true).
+		Select(COLUMN_CATEGORY_ID).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(productID)).
+		Order(goqu.I(COLUMN_CREATED_AT).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []string{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []string{}, err
+	}
+
+	categoryIDs := lo.Map(modelMaps, func(modelMap map[string]string, index int) string {
+		return modelMap[COLUMN_CATEGORY_ID]
+	})
+
+	return categoryIDs, nil
+}
+
+// ProductCategoryRemove removes the product from the category
+func (store *Store) ProductCategoryRemove(ctx context.Context, productID string, categoryID string) error {
+	if productID == "" {
+		return errors.New("product id is empty")
+	}
+
+	if categoryID == "" {
+		return errors.New("category id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.productCategoryTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(productID)).
+		Where(goqu.C(COLUMN_CATEGORY_ID).Eq(categoryID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// productCategoryIDsWithDescendants expands the given category IDs with
+// the IDs of all their descendant categories
+func (store *Store) productCategoryIDsWithDescendants(ctx context.Context, categoryIDs []string) ([]string, error) {
+	descendants, err := store.categoryDescendants(ctx, categoryIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	descendantIDs := lo.Map(descendants, func(category CategoryInterface, index int) string {
+		return category.ID()
+	})
+
+	return lo.Uniq(append(categoryIDs, descendantIDs...)), nil
+}
@@ -0,0 +1,184 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductCategoryAdd(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if store == nil {
+		t.Fatal("unexpected nil store")
+	}
+
+	ctx := context.Background()
+
+	err = store.ProductCategoryAdd(ctx, "PRODUCT_01", "CATEGORY_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// adding twice must not duplicate the assignment
+	err = store.ProductCategoryAdd(ctx, "PRODUCT_01", "CATEGORY_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	categoryIDs, err := store.ProductCategoryList(ctx, "PRODUCT_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(categoryIDs) != 1 {
+		t.Fatal("Category IDs MUST be 1, found:", len(categoryIDs))
+	}
+
+	if categoryIDs[0] != "CATEGORY_01" {
+		t.Fatal("Category ID MUST be CATEGORY_01, found:", categoryIDs[0])
+	}
+
+	// the assignment is unique, also when added past the check
+	_, err = store.DB().Exec(`INSERT INTO shop_product_category (id, product_id, category_id, created_at)` +
+		` VALUES ('DUPLICATE', 'PRODUCT_01', 'CATEGORY_01', '2020-01-01 00:00:00')`)
+
+	if err == nil {
+		t.Fatal("Duplicate assignment MUST fail")
+	}
+}
+
+func TestStoreProductCategoryRemove(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if store == nil {
+		t.Fatal("unexpected nil store")
+	}
+
+	ctx := context.Background()
+
+	err = store.ProductCategoryAdd(ctx, "PRODUCT_01", "CATEGORY_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.ProductCategoryAdd(ctx, "PRODUCT_01", "CATEGORY_02")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.ProductCategoryRemove(ctx, "PRODUCT_01", "CATEGORY_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	categoryIDs, err := store.ProductCategoryList(ctx, "PRODUCT_01")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(categoryIDs) != 1 {
+		t.Fatal("Category IDs MUST be 1, found:", len(categoryIDs))
+	}
+
+	if categoryIDs[0] != "CATEGORY_02" {
+		t.Fatal("Category ID MUST be CATEGORY_02, found:", categoryIDs[0])
+	}
+}
+
+func TestStoreProductListByCategory(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if store == nil {
+		t.Fatal("unexpected nil store")
+	}
+
+	ctx := context.Background()
+
+	parent := NewCategory().SetTitle("Clothing")
+	child := NewCategory().SetTitle("T-Shirts").SetParentID(parent.ID())
+
+	for _, category := range []CategoryInterface{parent, child} {
+		if err := store.CategoryCreate(ctx, category); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	jacket := NewProduct().SetTitle("Jacket")
+	tshirt := NewProduct().SetTitle("T-Shirt")
+	ruler := NewProduct().SetTitle("Ruler")
+
+	for _, product := range []ProductInterface{jacket, tshirt, ruler} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if err := store.ProductCategoryAdd(ctx, jacket.ID(), parent.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductCategoryAdd(ctx, tshirt.ID(), child.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetCategoryID(parent.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatal("Product list MUST be 1, found:", len(list))
+	}
+
+	if list[0].ID() != jacket.ID() {
+		t.Fatal("Product MUST be the jacket, found:", list[0].Title())
+	}
+
+	count, err := store.ProductCount(ctx, NewProductQuery().
+		SetCategoryID(parent.ID()).
+		SetCategoryDescendantsIncluded(true))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 2 {
+		t.Fatal("Product count MUST be 2, found:", count)
+	}
+
+	list, err = store.ProductList(ctx, NewProductQuery().
+		SetCategoryIDIn([]string{child.ID()}))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatal("Product list MUST be 1, found:", len(list))
+	}
+
+	if list[0].ID() != tshirt.ID() {
+		t.Fatal("Product MUST be the t-shirt, found:", list[0].Title())
+	}
+}
@@ -0,0 +1,284 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/samber/lo"
+)
+
+// ProductOptionCreate adds the option to its product
+func (store *Store) ProductOptionCreate(ctx context.Context, option ProductOptionInterface) error {
+	if option == nil {
+		return errors.New("product option is nil")
+	}
+
+	if option.ProductID() == "" {
+		return errors.New("product option product id is empty")
+	}
+
+	if option.Title() == "" {
+		return errors.New("product option title is empty")
+	}
+
+	option.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	option.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.productOptionInsert(ctx, store.productOptionTableName, option.Data(), option.MarkAsNotDirty)
+}
+
+// ProductOptionDelete deletes the option together with its values
+func (store *Store) ProductOptionDelete(ctx context.Context, option ProductOptionInterface) error {
+	if option == nil {
+		return errors.New("product option is nil")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		if err := store.productOptionDelete(txCtx, store.productOptionValueTableName, goqu.C(COLUMN_OPTION_ID).Eq(option.ID())); err != nil {
+			return err
+		}
+
+		return store.productOptionDelete(txCtx, store.productOptionTableName, goqu.C(COLUMN_ID).Eq(option.ID()))
+	})
+}
+
+func (store *Store) ProductOptionFindByID(ctx context.Context, id string) (ProductOptionInterface, error) {
+	if id == "" {
+		return nil, errors.New("product option id is empty")
+	}
+
+	modelMaps, err := store.productOptionSelect(ctx, store.productOptionTableName, goqu.C(COLUMN_ID).Eq(id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modelMaps) > 0 {
+		return NewProductOptionFromExistingData(modelMaps[0]), nil
+	}
+
+	return nil, nil
+}
+
+// ProductOptionList returns the options of the product in sequence
+func (store *Store) ProductOptionList(ctx context.Context, productID string) ([]ProductOptionInterface, error) {
+	if productID == "" {
+		return []ProductOptionInterface{}, errors.New("product id is empty")
+	}
+
+	modelMaps, err := store.productOptionSelect(ctx, store.productOptionTableName, goqu.C(COLUMN_PRODUCT_ID).Eq(productID))
+
+	if err != nil {
+		return []ProductOptionInterface{}, err
+	}
+
+	list := lo.Map(modelMaps, func(modelMap map[string]string, index int) ProductOptionInterface {
+		return NewProductOptionFromExistingData(modelMap)
+	})
+
+	return list, nil
+}
+
+func (store *Store) ProductOptionUpdate(ctx context.Context, option ProductOptionInterface) error {
+	if option == nil {
+		return errors.New("product option is nil")
+	}
+
+	option.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	dataChanged := option.DataChanged()
+
+	delete(dataChanged, COLUMN_ID)         // ID is not updateable
+	delete(dataChanged, COLUMN_PRODUCT_ID) // Product is not updateable
+
+	err := store.productOptionUpdate(ctx, store.productOptionTableName, option.ID(), dataChanged)
+
+	option.MarkAsNotDirty()
+
+	return err
+}
+
+// ProductOptionValueCreate adds the value to its option
+func (store *Store) ProductOptionValueCreate(ctx context.Context, optionValue ProductOptionValueInterface) error {
+	if optionValue == nil {
+		return errors.New("product option value is nil")
+	}
+
+	if optionValue.OptionID() == "" {
+		return errors.New("product option value option id is empty")
+	}
+
+	if optionValue.Title() == "" {
+		return errors.New("product option value title is empty")
+	}
+
+	option, err := store.ProductOptionFindByID(ctx, optionValue.OptionID())
+
+	if err != nil {
+		return err
+	}
+
+	if option == nil {
+		return errors.New("product option " + optionValue.OptionID() + " not found")
+	}
+
+	optionValue.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	optionValue.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.productOptionInsert(ctx, store.productOptionValueTableName, optionValue.Data(), optionValue.MarkAsNotDirty)
+}
+
+func (store *Store) ProductOptionValueDelete(ctx context.Context, optionValue ProductOptionValueInterface) error {
+	if optionValue == nil {
+		return errors.New("product option value is nil")
+	}
+
+	return store.productOptionDelete(ctx, store.productOptionValueTableName, goqu.C(COLUMN_ID).Eq(optionValue.ID()))
+}
+
+func (store *Store) ProductOptionValueFindByID(ctx context.Context, id string) (ProductOptionValueInterface, error) {
+	if id == "" {
+		return nil, errors.New("product option value id is empty")
+	}
+
+	modelMaps, err := store.productOptionSelect(ctx, store.productOptionValueTableName, goqu.C(COLUMN_ID).Eq(id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modelMaps) > 0 {
+		return NewProductOptionValueFromExistingData(modelMaps[0]), nil
+	}
+
+	return nil, nil
+}
+
+// ProductOptionValueList returns the values of the option in sequence
+func (store *Store) ProductOptionValueList(ctx context.Context, optionID string) ([]ProductOptionValueInterface, error) {
+	if optionID == "" {
+		return []ProductOptionValueInterface{}, errors.New("product option id is empty")
+	}
+
+	modelMaps, err := store.productOptionSelect(ctx, store.productOptionValueTableName, goqu.C(COLUMN_OPTION_ID).Eq(optionID))
+
+	if err != nil {
+		return []ProductOptionValueInterface{}, err
+	}
+
+	list := lo.Map(modelMaps, func(modelMap map[string]string, index int) ProductOptionValueInterface {
+		return NewProductOptionValueFromExistingData(modelMap)
+	})
+
+	return list, nil
+}
+
+func (store *Store) ProductOptionValueUpdate(ctx context.Context, optionValue ProductOptionValueInterface) error {
+	if optionValue == nil {
+		return errors.New("product option value is nil")
+	}
+
+	optionValue.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	dataChanged := optionValue.DataChanged()
+
+	delete(dataChanged, COLUMN_ID)        // ID is not updateable
+	delete(dataChanged, COLUMN_OPTION_ID) // Option is not updateable
+
+	err := store.productOptionUpdate(ctx, store.productOptionValueTableName, optionValue.ID(), dataChanged)
+
+	optionValue.MarkAsNotDirty()
+
+	return err
+}
+
+// The options and their values are stored alike,
+// the helpers below work with either of the tables
+
+func (store *Store) productOptionDelete(ctx context.Context, tableName string, where goqu.Expression) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(tableName).
+		Prepared(true).
+		Where(where).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) productOptionInsert(ctx context.Context, tableName string, data map[string]string, markAsNotDirty func()) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(tableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	markAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) productOptionSelect(ctx context.Context, tableName string, where goqu.Expression) ([]map[string]string, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(tableName).
+		Prepared(true).
+		Where(where).
+		Order(goqu.I(COLUMN_SEQUENCE).Asc(), goqu.I(COLUMN_CREATED_AT).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []map[string]string{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	return database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+}
+
+func (store *Store) productOptionUpdate(ctx context.Context, tableName string, id string, dataChanged map[string]string) error {
+	delete(dataChanged, "hash") // Hash is not updateable
+	delete(dataChanged, "data") // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(tableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
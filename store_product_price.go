@@ -0,0 +1,201 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/samber/lo"
+)
+
+// ProductPriceSet sets the price of the product in the currency of the price,
+// replacing the existing price in that currency if there is one. A product
+// has one price per currency, of concurrent first sets all but one fail.
+func (store *Store) ProductPriceSet(ctx context.Context, productID string, price Money) error {
+	if productID == "" {
+		return errors.New("product id is empty")
+	}
+
+	if price.Currency() == "" {
+		return errors.New("price currency is empty")
+	}
+
+	if price.IsNegative() {
+		return errors.New("price cannot be negative")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		existing, err := store.ProductPriceGet(txCtx, productID, price.Currency())
+
+		if err != nil {
+			return err
+		}
+
+		if existing == nil {
+			productPrice := NewProductPrice().
+				SetProductID(productID).
+				SetPriceMoney(price)
+
+			return store.productPriceInsert(txCtx, productPrice)
+		}
+
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Update(store.productPriceTableName).
+			Prepared(true).
+			Set(map[string]string{
+				COLUMN_PRICE:      price.String(),
+				COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+			}).
+			Where(goqu.C(COLUMN_ID).Eq(existing.ID())).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("update", sqlStr, params...)
+
+		_, err = database.Execute(store.toQuerableContext(txCtx), sqlStr, params...)
+
+		return err
+	})
+}
+
+// ProductPriceGet returns the price of the product in the currency,
+// or nil if the product has no price set in that currency
+func (store *Store) ProductPriceGet(ctx context.Context, productID string, currency string) (ProductPriceInterface, error) {
+	if productID == "" {
+		return nil, errors.New("product id is empty")
+	}
+
+	if currency == "" {
+		return nil, errors.New("currency is empty")
+	}
+
+	list, err := store.productPriceSelect(ctx, goqu.And(
+		goqu.C(COLUMN_PRODUCT_ID).Eq(productID),
+		goqu.C(COLUMN_CURRENCY).Eq(strings.ToUpper(currency)),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+// ProductPriceList returns the prices of the product in all currencies,
+// ordered by currency
+func (store *Store) ProductPriceList(ctx context.Context, productID string) ([]ProductPriceInterface, error) {
+	if productID == "" {
+		return []ProductPriceInterface{}, errors.New("product id is empty")
+	}
+
+	return store.productPriceSelect(ctx, goqu.C(COLUMN_PRODUCT_ID).Eq(productID))
+}
+
+// ProductPriceRemove removes the price of the product in the currency
+func (store *Store) ProductPriceRemove(ctx context.Context, productID string, currency string) error {
+	if productID == "" {
+		return errors.New("product id is empty")
+	}
+
+	if currency == "" {
+		return errors.New("currency is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.productPriceTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(productID)).
+		Where(goqu.C(COLUMN_CURRENCY).Eq(strings.ToUpper(currency))).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// productPriceFilter matches the products whose price in the currency of
+// the amount compares to it, the price set for the currency or else the
+// price of the product itself when in that currency (see productPriceIn)
+func (store *Store) productPriceFilter(amount Money, compare func(price exp.IdentifierExpression, amount float64) exp.Expression) exp.Expression {
+	pricedIn := goqu.Dialect(store.dbDriverName).
+		From(store.productPriceTableName).
+		Select(COLUMN_PRODUCT_ID).
+		Where(goqu.C(COLUMN_CURRENCY).Eq(amount.Currency()))
+
+	return goqu.Or(
+		goqu.C(COLUMN_ID).In(pricedIn.Where(compare(goqu.C(COLUMN_PRICE), amount.Float()))),
+		goqu.And(
+			goqu.C(COLUMN_CURRENCY).Eq(amount.Currency()),
+			compare(goqu.C(COLUMN_PRICE), amount.Float()),
+			goqu.C(COLUMN_ID).NotIn(pricedIn),
+		),
+	)
+}
+
+func (store *Store) productPriceInsert(ctx context.Context, productPrice ProductPriceInterface) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.productPriceTableName).
+		Prepared(true).
+		Rows(productPrice.Data()).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	productPrice.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) productPriceSelect(ctx context.Context, where goqu.Expression) ([]ProductPriceInterface, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.productPriceTableName).
+		Prepared(true).
+		Where(where).
+		Order(goqu.I(COLUMN_CURRENCY).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []ProductPriceInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []ProductPriceInterface{}, err
+	}
+
+	list := lo.Map(modelMaps, func(modelMap map[string]string, index int) ProductPriceInterface {
+		return NewProductPriceFromExistingData(modelMap)
+	})
+
+	return list, nil
+}
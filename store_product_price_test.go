@@ -0,0 +1,123 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductPrice(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetTitle("Product 1").
+		SetPriceMoney(NewMoney(1999, "USD"))
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	prices := []Money{
+		NewMoney(1599, "GBP"),
+		NewMoney(1799, "EUR"),
+		NewMoney(1999, "USD"),
+		NewMoney(1699, "GBP"), // replaces the GBP price
+	}
+
+	for _, price := range prices {
+		if err := store.ProductPriceSet(ctx, product.ID(), price); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	list, err := store.ProductPriceList(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 3 {
+		t.Fatal("Product prices MUST be 3, found:", len(list))
+	}
+
+	if list[0].Currency() != "EUR" || list[1].Currency() != "GBP" || list[2].Currency() != "USD" {
+		t.Fatal("Product prices MUST be ordered by currency, found:", list[0].Currency(), list[1].Currency(), list[2].Currency())
+	}
+
+	gbp, err := store.ProductPriceGet(ctx, product.ID(), "gbp")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if gbp == nil {
+		t.Fatal("GBP price MUST NOT be nil")
+	}
+
+	if !gbp.PriceMoney().Equals(NewMoney(1699, "GBP")) {
+		t.Fatal("GBP price MUST be 16.99, found:", gbp.PriceMoney().String())
+	}
+
+	if err := store.ProductPriceRemove(ctx, product.ID(), "EUR"); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	eur, err := store.ProductPriceGet(ctx, product.ID(), "EUR")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if eur != nil {
+		t.Fatal("EUR price MUST be nil after removal")
+	}
+
+	if err := store.ProductPriceSet(ctx, product.ID(), NewMoney(-1, "EUR")); err == nil {
+		t.Fatal("expected error, price is negative")
+	}
+
+	// one price per currency, also when inserted past the check
+	err = store.(*Store).productPriceInsert(ctx, NewProductPrice().
+		SetProductID(product.ID()).
+		SetPriceMoney(NewMoney(1599, "GBP")))
+
+	if err == nil {
+		t.Fatal("Second GBP price MUST fail")
+	}
+}
+
+func TestStorePlaceOrderCurrencyMismatch(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Product 1").
+		SetQuantityInt(10)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().
+		SetCustomerID("CUSTOMER01_ID").
+		SetCurrency("GBP")
+
+	lineItem := NewOrderLineItem().
+		SetProductID(product.ID()).
+		SetPriceMoney(NewMoney(1999, "USD"))
+
+	if err := store.PlaceOrder(ctx, order, []OrderLineItemInterface{lineItem}); err == nil {
+		t.Fatal("expected error, line item currency does not match the order currency")
+	}
+}
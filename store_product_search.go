@@ -0,0 +1,311 @@
+package shopstore
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/gouniverse/sb"
+)
+
+// productSearchColumns are the product columns matched by the search,
+// the most relevant first
+var productSearchColumns = []string{
+	COLUMN_TITLE,
+	COLUMN_SHORT_DESCRIPTION,
+	COLUMN_DESCRIPTION,
+	COLUMN_METAS,
+}
+
+// productSearchMigrate sets up the full-text search of the products: an FTS5
+// table kept in sync by triggers on SQLite, a FULLTEXT index on MySQL and
+// a GIN index on Postgres. Products are searched with LIKE when the driver
+// has no full-text search, or SQLite is built without FTS5. Stores not
+// migrating find the full-text search set up before with
+// productSearchIndexExists.
+func (store *Store) productSearchMigrate() error {
+	store.productSearchFullText = false
+
+	switch store.dbDriverName {
+	case sb.DIALECT_SQLITE:
+		return store.productSearchMigrateSqlite()
+	case sb.DIALECT_MYSQL:
+		return store.productSearchMigrateMysql()
+	case sb.DIALECT_POSTGRES:
+		sqlStr := `CREATE INDEX IF NOT EXISTS ` + store.productSearchTableName() +
+			` ON ` + store.productTableName +
+			` USING GIN (to_tsvector('simple', ` + store.productSearchDocumentSql() + `))`
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+
+		store.productSearchFullText = true
+	}
+
+	return nil
+}
+
+func (store *Store) productSearchMigrateMysql() error {
+	exists, err := store.productSearchIndexExists()
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		sqlStr := `CREATE FULLTEXT INDEX ` + store.productSearchTableName() +
+			` ON ` + store.productTableName + ` (` + strings.Join(productSearchColumns, `, `) + `)`
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+	}
+
+	store.productSearchFullText = true
+
+	return nil
+}
+
+func (store *Store) productSearchMigrateSqlite() error {
+	tableName := store.productSearchTableName()
+
+	exists, err := store.productSearchIndexExists()
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		sqlStr := `CREATE VIRTUAL TABLE ` + tableName + ` USING fts5(` +
+			COLUMN_ID + ` UNINDEXED, ` + strings.Join(productSearchColumns, `, `) + `)`
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			if strings.Contains(err.Error(), `no such module: fts5`) {
+				return nil // built without FTS5, searched with LIKE
+			}
+
+			return err
+		}
+
+		sqlStr = `INSERT INTO ` + tableName + ` SELECT ` + COLUMN_ID + `, ` +
+			strings.Join(productSearchColumns, `, `) + ` FROM ` + store.productTableName
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+	}
+
+	columns := COLUMN_ID + `, ` + strings.Join(productSearchColumns, `, `)
+	values := `new.` + COLUMN_ID + `, new.` + strings.Join(productSearchColumns, `, new.`)
+	insertSql := `INSERT INTO ` + tableName + ` (` + columns + `) VALUES (` + values + `);`
+	deleteSql := `DELETE FROM ` + tableName + ` WHERE ` + COLUMN_ID + ` = old.` + COLUMN_ID + `;`
+
+	triggers := map[string]string{
+		`_insert`: `AFTER INSERT ON ` + store.productTableName + ` BEGIN ` + insertSql + ` END`,
+		`_update`: `AFTER UPDATE ON ` + store.productTableName + ` BEGIN ` + deleteSql + ` ` + insertSql + ` END`,
+		`_delete`: `AFTER DELETE ON ` + store.productTableName + ` BEGIN ` + deleteSql + ` END`,
+	}
+
+	for suffix, trigger := range triggers {
+		sqlStr := `CREATE TRIGGER IF NOT EXISTS ` + tableName + suffix + ` ` + trigger
+
+		if _, err := store.db.Exec(sqlStr); err != nil {
+			return err
+		}
+	}
+
+	store.productSearchFullText = true
+
+	return nil
+}
+
+// productSearchIndexExists returns true if the full-text search of the
+// products has been set up (see productSearchMigrate)
+func (store *Store) productSearchIndexExists() (bool, error) {
+	name := store.productSearchTableName()
+
+	var row *sql.Row
+
+	switch store.dbDriverName {
+	case sb.DIALECT_SQLITE:
+		row = store.db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, name)
+	case sb.DIALECT_MYSQL:
+		row = store.db.QueryRow(`SELECT COUNT(*) FROM information_schema.statistics`+
+			` WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?`, store.productTableName, name)
+	case sb.DIALECT_POSTGRES:
+		row = store.db.QueryRow(`SELECT COUNT(*) FROM pg_indexes WHERE tablename = $1 AND indexname = $2`, store.productTableName, name)
+	default:
+		return false, nil
+	}
+
+	count := 0
+
+	if err := row.Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// productSearch filters the products to the ones matching the search, and
+// orders them by relevance when ranked. Full-text search is used when set
+// up by AutoMigrate, otherwise every word must be LIKE one of the columns.
+func (store *Store) productSearch(q *goqu.SelectDataset, search string, ranked bool) *goqu.SelectDataset {
+	words := strings.Fields(search)
+
+	if len(words) < 1 {
+		return q
+	}
+
+	if !store.productSearchFullText {
+		return store.productSearchLike(q, search, words, ranked)
+	}
+
+	switch store.dbDriverName {
+	case sb.DIALECT_SQLITE:
+		tableName := store.productSearchTableName()
+		match := productSearchMatchSqlite(words)
+
+		matches := goqu.Dialect(store.dbDriverName).
+			From(tableName).
+			Select(COLUMN_ID).
+			Where(goqu.L(`? MATCH ?`, goqu.T(tableName), match))
+
+		q = q.Where(goqu.C(COLUMN_ID).In(matches))
+
+		if ranked {
+			// bm25 is lower the more relevant, the title weighs the most
+			rank := goqu.Dialect(store.dbDriverName).
+				From(tableName).
+				Select(goqu.L(`bm25(?, 0, 10, 5, 2, 1)`, goqu.T(tableName))).
+				Where(goqu.L(`? MATCH ?`, goqu.T(tableName), match)).
+				Where(goqu.T(tableName).Col(COLUMN_ID).Eq(goqu.T(store.productTableName).Col(COLUMN_ID)))
+
+			q = q.Order(goqu.L(`(?)`, rank).Asc())
+		}
+	case sb.DIALECT_MYSQL:
+		match := productSearchMatchMysql(words)
+
+		if match == "" {
+			return store.productSearchLike(q, search, words, ranked)
+		}
+
+		columns := []any{}
+
+		for _, column := range productSearchColumns {
+			columns = append(columns, goqu.C(column))
+		}
+
+		against := goqu.L(`MATCH(`+strings.Repeat(`?, `, len(columns)-1)+`?) AGAINST (? IN BOOLEAN MODE)`,
+			append(columns, match)...)
+
+		q = q.Where(against)
+
+		if ranked {
+			q = q.Order(against.Desc())
+		}
+	case sb.DIALECT_POSTGRES:
+		document := goqu.L(`to_tsvector('simple', ` + store.productSearchDocumentSql() + `)`)
+		query := goqu.L(`plainto_tsquery('simple', ?)`, search)
+
+		q = q.Where(goqu.L(`? @@ ?`, document, query))
+
+		if ranked {
+			q = q.Order(goqu.L(`ts_rank(?, ?)`, document, query).Desc())
+		}
+	}
+
+	return q
+}
+
+// productSearchLike is the portable search, every word must be in one of
+// the columns. Ranked, the products with the whole search in the title
+// come first, then in the short description, then the rest.
+func (store *Store) productSearchLike(q *goqu.SelectDataset, search string, words []string, ranked bool) *goqu.SelectDataset {
+	like := func(column string, text string) exp.Expression {
+		return goqu.L(`LOWER(?) LIKE ? ESCAPE '!'`, goqu.C(column), `%`+productSearchLikeEscape(strings.ToLower(text))+`%`)
+	}
+
+	for _, word := range words {
+		matches := []exp.Expression{}
+
+		for _, column := range productSearchColumns {
+			matches = append(matches, like(column, word))
+		}
+
+		q = q.Where(goqu.Or(matches...))
+	}
+
+	if ranked {
+		search = strings.Join(words, ` `)
+
+		rank := goqu.Case().
+			When(like(COLUMN_TITLE, search), 3).
+			When(like(COLUMN_SHORT_DESCRIPTION, search), 2).
+			When(like(COLUMN_DESCRIPTION, search), 1).
+			Else(0)
+
+		q = q.Order(rank.Desc(), goqu.C(COLUMN_TITLE).Asc())
+	}
+
+	return q
+}
+
+// productSearchDocumentSql returns the columns searched on Postgres joined
+// into one document, the same for the index and the queries to use it
+func (store *Store) productSearchDocumentSql() string {
+	parts := []string{}
+
+	for _, column := range productSearchColumns {
+		parts = append(parts, `coalesce(`+column+`, '')`)
+	}
+
+	return strings.Join(parts, ` || ' ' || `)
+}
+
+// productSearchTableName is the name of the FTS5 table on SQLite, and
+// of the full-text index on MySQL and Postgres
+func (store *Store) productSearchTableName() string {
+	return store.productTableName + "_search"
+}
+
+// productSearchMatchSqlite returns the FTS5 query matching the words as
+// prefixes, each quoted so any characters typed are searched for literally
+func productSearchMatchSqlite(words []string) string {
+	terms := []string{}
+
+	for _, word := range words {
+		terms = append(terms, `"`+strings.ReplaceAll(word, `"`, `""`)+`"*`)
+	}
+
+	return strings.Join(terms, ` `)
+}
+
+// productSearchLikeEscape escapes the LIKE wildcards in the text, so
+// % and _ typed are searched for literally (with ESCAPE '!')
+func productSearchLikeEscape(text string) string {
+	return strings.NewReplacer(`!`, `!!`, `%`, `!%`, `_`, `!_`).Replace(text)
+}
+
+// productSearchMatchMysql returns the boolean mode query requiring every
+// word as a prefix, as on SQLite. The boolean operators typed are removed.
+func productSearchMatchMysql(words []string) string {
+	operators := strings.NewReplacer(`+`, ``, `-`, ``, `<`, ``, `>`, ``, `(`, ``, `)`, ``,
+		`~`, ``, `*`, ``, `"`, ``, `@`, ``)
+
+	terms := []string{}
+
+	for _, word := range words {
+		word = operators.Replace(word)
+
+		if word == "" {
+			continue
+		}
+
+		terms = append(terms, `+`+word+`*`)
+	}
+
+	return strings.Join(terms, ` `)
+}
@@ -0,0 +1,164 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductSearch(t *testing.T) {
+	for _, fullText := range []bool{true, false} {
+		store, err := initStore(":memory:")
+
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !store.(*Store).productSearchFullText {
+			t.Fatal("Product search MUST be full-text on SQLite")
+		}
+
+		store.(*Store).productSearchFullText = fullText
+
+		ctx := context.Background()
+
+		notebook := NewProduct().
+			SetTitle("Notebook").
+			SetDescription("Squared pages, pairs well with a blue pencil")
+
+		pencil := NewProduct().
+			SetTitle("Blue Pencil").
+			SetShortDescription("HB")
+
+		pen := NewProduct().
+			SetTitle("Red Pen")
+
+		for _, product := range []ProductInterface{notebook, pencil, pen} {
+			if err := store.ProductCreate(ctx, product); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+		}
+
+		list, err := store.ProductList(ctx, NewProductQuery().SetSearch("blue penc"))
+
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if len(list) != 2 {
+			t.Fatal("Products found MUST be 2, found:", len(list), "full-text:", fullText)
+		}
+
+		if list[0].ID() != pencil.ID() || list[1].ID() != notebook.ID() {
+			t.Fatal("Product with the search in the title MUST come first, found:", list[0].Title(), "full-text:", fullText)
+		}
+
+		count, err := store.ProductCount(ctx, NewProductQuery().SetSearch("pen"))
+
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if count != 3 {
+			t.Fatal("Products counted MUST be 3, found:", count, "full-text:", fullText)
+		}
+
+		pen.SetTitle("Blue Pen")
+
+		if err := store.ProductUpdate(ctx, pen); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if err := store.ProductDelete(ctx, notebook); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		list, err = store.ProductList(ctx, NewProductQuery().
+			SetSearch("blue").
+			SetOrderBy(COLUMN_TITLE).
+			SetSortDirection("asc"))
+
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if len(list) != 2 || list[0].ID() != pen.ID() || list[1].ID() != pencil.ID() {
+			t.Fatal("Products found MUST be the blue pen and pencil, found:", len(list), "full-text:", fullText)
+		}
+	}
+}
+
+func TestStoreProductSearchWithoutAutoMigrate(t *testing.T) {
+	db, err := initDB(t.TempDir() + "/test_product_search.db")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	defer db.Close()
+
+	if _, err := NewStore(initStoreOptions(db)); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	options := initStoreOptions(db)
+	options.AutomigrateEnabled = false
+
+	store, err := NewStore(options)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !store.productSearchFullText {
+		t.Fatal("Product search MUST be full-text, set up by the earlier AutoMigrate")
+	}
+}
+
+func TestStoreProductSearchLikeWildcards(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	store.(*Store).productSearchFullText = false
+
+	ctx := context.Background()
+
+	cotton := NewProduct().SetTitle("100% Cotton Shirt")
+	blend := NewProduct().SetTitle("1000 Thread Blend")
+
+	for _, product := range []ProductInterface{cotton, blend} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().SetSearch("100%"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].ID() != cotton.ID() {
+		t.Fatal("Products found MUST be the cotton shirt only, found:", len(list))
+	}
+
+	count, err := store.ProductCount(ctx, NewProductQuery().SetSearch("_"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 0 {
+		t.Fatal("Products counted MUST be 0, an underscore is not a wildcard, found:", count)
+	}
+}
+
+func TestProductSearchMatchMysql(t *testing.T) {
+	match := productSearchMatchMysql([]string{"blue", "-penc*", "+"})
+
+	if match != "+blue* +penc*" {
+		t.Fatal("MySQL match MUST be +blue* +penc*, found:", match)
+	}
+}
@@ -179,3 +179,250 @@ func TestStoreProductSoftDelete(t *testing.T) {
 	}
 
 }
+
+func TestStoreProductFindBySKUAndBarcode(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	ruler := NewProduct().
+		SetTitle("Ruler").
+		SetSKU("RUL-30").
+		SetBarcode("4006381333931")
+
+	pencil := NewProduct().
+		SetTitle("Pencil").
+		SetSKU("PEN-HB")
+
+	// Products without SKU and barcode do not clash
+	eraser := NewProduct().SetTitle("Eraser")
+	sharpener := NewProduct().SetTitle("Sharpener")
+
+	for _, product := range []ProductInterface{ruler, pencil, eraser, sharpener} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	productFound, err := store.ProductFindBySKU(ctx, "RUL-30")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound == nil || productFound.ID() != ruler.ID() {
+		t.Fatal("Product with SKU RUL-30 MUST be the ruler, found:", productFound)
+	}
+
+	productFound, err = store.ProductFindByBarcode(ctx, "4006381333931")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound == nil || productFound.ID() != ruler.ID() {
+		t.Fatal("Product with barcode 4006381333931 MUST be the ruler, found:", productFound)
+	}
+
+	productFound, err = store.ProductFindByID(ctx, eraser.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.SKU() != "" || productFound.Barcode() != "" {
+		t.Fatal("Product SKU and barcode MUST be empty, found:", productFound.SKU(), productFound.Barcode())
+	}
+
+	count, err := store.ProductCount(ctx, NewProductQuery().SetSKUIn([]string{"RUL-30", "PEN-HB", "NONE"}))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 2 {
+		t.Fatal("Product count MUST be 2, found:", count)
+	}
+
+	duplicate := NewProduct().
+		SetTitle("Long ruler").
+		SetSKU("RUL-30")
+
+	if err := store.ProductCreate(ctx, duplicate); err == nil {
+		t.Fatal("Product with a duplicate SKU MUST NOT be created")
+	}
+
+	eraser.SetBarcode("4006381333931")
+
+	if err := store.ProductUpdate(ctx, eraser); err == nil {
+		t.Fatal("Product with a duplicate barcode MUST NOT be updated")
+	}
+}
+
+func TestStoreProductSoftDeleteReleasesSKUAndBarcode(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	ruler := NewProduct().
+		SetTitle("Ruler").
+		SetSKU("RUL-30").
+		SetBarcode("4006381333931")
+
+	if err := store.ProductCreate(ctx, ruler); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductSoftDeleteByID(ctx, ruler.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The SKU and barcode of the retired product can be reused
+	newRuler := NewProduct().
+		SetTitle("New ruler").
+		SetSKU("RUL-30").
+		SetBarcode("4006381333931")
+
+	if err := store.ProductCreate(ctx, newRuler); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindBySKU(ctx, "RUL-30")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound == nil || productFound.ID() != newRuler.ID() {
+		t.Fatal("Product with SKU RUL-30 MUST be the new ruler, found:", productFound)
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetID(ruler.ID()).
+		SetSoftDeletedIncluded(true))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].SKU() != "" || list[0].Barcode() != "" {
+		t.Fatal("Soft deleted product MUST have no SKU and barcode")
+	}
+}
+
+func TestStoreProductDeleteWithOwnedRows(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	shirt := NewProduct().SetTitle("Red Shirt")
+	scarf := NewProduct().SetTitle("Red Scarf")
+
+	for _, product := range []ProductInterface{shirt, scarf} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	optionValues := []ProductOptionValueInterface{}
+
+	for _, product := range []ProductInterface{shirt, scarf} {
+		if err := store.ProductCategoryAdd(ctx, product.ID(), "CATEGORY_01"); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if err := store.ProductPriceSet(ctx, product.ID(), NewMoney(1999, "EUR")); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		option := NewProductOption().SetProductID(product.ID()).SetTitle("Size")
+
+		if err := store.ProductOptionCreate(ctx, option); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		optionValue := NewProductOptionValue().SetOptionID(option.ID()).SetTitle("M")
+
+		if err := store.ProductOptionValueCreate(ctx, optionValue); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		optionValues = append(optionValues, optionValue)
+
+		variant := NewProductVariant().SetProductID(product.ID()).SetTitle("M")
+
+		if err := store.ProductVariantCreate(ctx, variant); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	shirt.SetSlug("shirt")
+
+	if err := store.ProductUpdate(ctx, shirt); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductDeleteByID(ctx, shirt.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, tableName := range []string{
+		store.ProductCategoryTableName(),
+		store.ProductPriceTableName(),
+		store.ProductOptionTableName(),
+		store.ProductOptionValueTableName(),
+		store.ProductVariantTableName(),
+	} {
+		count := 0
+
+		if err := store.DB().QueryRow(`SELECT COUNT(*) FROM ` + tableName).Scan(&count); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if count != 1 {
+			t.Fatal("Only the scarf MUST have rows in "+tableName+", found:", count)
+		}
+	}
+
+	optionValueFound, err := store.ProductOptionValueFindByID(ctx, optionValues[1].ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if optionValueFound == nil {
+		t.Fatal("Option value of the scarf MUST NOT be deleted")
+	}
+
+	redirects, err := store.SlugRedirectList(ctx, SLUG_REDIRECT_ENTITY_TYPE_PRODUCT, shirt.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(redirects) != 0 {
+		t.Fatal("Product slug redirects MUST be deleted, found:", len(redirects))
+	}
+
+	productFound, err := store.ProductFindBySlug(ctx, "red-shirt")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound != nil {
+		t.Fatal("Product MUST NOT be found by its previous slug")
+	}
+}
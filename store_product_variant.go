@@ -0,0 +1,481 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) ProductVariantCount(ctx context.Context, options ProductVariantQueryInterface) (int64, error) {
+	q, _, err := store.productVariantQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+// ProductVariantCreate creates the variant of its product. The option
+// values of the variant must be values of the options of the product
+// (see ProductOptionCreate), and its SKU, if set, unique among the variants.
+func (store *Store) ProductVariantCreate(ctx context.Context, productVariant ProductVariantInterface) error {
+	if productVariant == nil {
+		return errors.New("product variant is nil")
+	}
+
+	productVariant.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	productVariant.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	productVariant.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		if err := store.productVariantValidate(txCtx, productVariant); err != nil {
+			return err
+		}
+
+		data := productVariant.Data()
+
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Insert(store.productVariantTableName).
+			Prepared(true).
+			Rows(data).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("insert", sqlStr, params...)
+
+		_, err := database.Execute(store.toQuerableContext(txCtx), sqlStr, params...)
+
+		if err != nil {
+			return err
+		}
+
+		productVariant.MarkAsNotDirty()
+
+		return nil
+	})
+}
+
+func (store *Store) ProductVariantDelete(ctx context.Context, productVariant ProductVariantInterface) error {
+	if productVariant == nil {
+		return errors.New("product variant is nil")
+	}
+
+	return store.ProductVariantDeleteByID(ctx, productVariant.ID())
+}
+
+func (store *Store) ProductVariantDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("product variant id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.productVariantTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) ProductVariantFindByID(ctx context.Context, id string) (ProductVariantInterface, error) {
+	if id == "" {
+		return nil, errors.New("product variant id is empty")
+	}
+
+	list, err := store.ProductVariantList(ctx, NewProductVariantQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) ProductVariantList(ctx context.Context, options ProductVariantQueryInterface) ([]ProductVariantInterface, error) {
+	q, columns, err := store.productVariantQuery(options)
+
+	if err != nil {
+		return []ProductVariantInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []ProductVariantInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []ProductVariantInterface{}, err
+	}
+
+	list := []ProductVariantInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewProductVariantFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) ProductVariantSoftDelete(ctx context.Context, productVariant ProductVariantInterface) error {
+	if productVariant == nil {
+		return errors.New("product variant is nil")
+	}
+
+	productVariant.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.ProductVariantUpdate(ctx, productVariant)
+}
+
+func (store *Store) ProductVariantSoftDeleteByID(ctx context.Context, id string) error {
+	productVariant, err := store.ProductVariantFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.ProductVariantSoftDelete(ctx, productVariant)
+}
+
+func (store *Store) ProductVariantUpdate(ctx context.Context, productVariant ProductVariantInterface) error {
+	if productVariant == nil {
+		return errors.New("product variant is nil")
+	}
+
+	productVariant.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := productVariant.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		if lo.SomeBy([]string{COLUMN_PRODUCT_ID, COLUMN_SKU, COLUMN_ATTRIBUTES}, func(column string) bool {
+			_, changed := dataChanged[column]
+			return changed
+		}) {
+			if err := store.productVariantValidate(txCtx, productVariant); err != nil {
+				return err
+			}
+		}
+
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Update(store.productVariantTableName).
+			Prepared(true).
+			Set(dataChanged).
+			Where(goqu.C(COLUMN_ID).Eq(productVariant.ID())).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("update", sqlStr, params...)
+
+		_, err := database.Execute(store.toQuerableContext(txCtx), sqlStr, params...)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	productVariant.MarkAsNotDirty()
+
+	return nil
+}
+
+// productVariantStockDecrement checks the variant of the product can be
+// sold and reduces its quantity, guarded like productStockDecrement
+func (store *Store) productVariantStockDecrement(ctx context.Context, productID string, variantID string, quantity int64) error {
+	product, err := store.ProductFindByID(ctx, productID)
+
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		return errors.New("product " + productID + " not found")
+	}
+
+	if !product.IsActive() {
+		return errors.New("product " + productID + " is not active")
+	}
+
+	variant, err := store.ProductVariantFindByID(ctx, variantID)
+
+	if err != nil {
+		return err
+	}
+
+	if variant == nil || variant.ProductID() != productID {
+		return errors.New("product variant " + variantID + " not found in product " + productID)
+	}
+
+	if !variant.IsActive() {
+		return errors.New("product variant " + variantID + " is not active")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productVariantTableName).
+		Prepared(true).
+		Set(goqu.Record{
+			COLUMN_QUANTITY:   goqu.L("? - ?", goqu.C(COLUMN_QUANTITY), quantity),
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(variantID)).
+		Where(goqu.C(COLUMN_QUANTITY).Gte(quantity)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	result, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected < 1 {
+		return errors.New("product variant " + variantID + " has insufficient stock")
+	}
+
+	return nil
+}
+
+// productVariantStockIncrement puts the quantity back into the stock of the
+// variant, e.g. when the order it was taken for is cancelled
+func (store *Store) productVariantStockIncrement(ctx context.Context, variantID string, quantity int64) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productVariantTableName).
+		Prepared(true).
+		Set(goqu.Record{
+			COLUMN_QUANTITY:   goqu.L("? + ?", goqu.C(COLUMN_QUANTITY), quantity),
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(variantID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+// productVariantValidate checks the variant belongs to an existing product,
+// has values of the options of the product and a unique SKU
+func (store *Store) productVariantValidate(ctx context.Context, productVariant ProductVariantInterface) error {
+	if productVariant.ProductID() == "" {
+		return errors.New("product variant product id is empty")
+	}
+
+	product, err := store.ProductFindByID(ctx, productVariant.ProductID())
+
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		return errors.New("product " + productVariant.ProductID() + " not found")
+	}
+
+	if productVariant.HasPrice() && productVariant.Currency() == "" {
+		return errors.New("product variant price currency is empty")
+	}
+
+	attributes, err := productVariant.Attributes()
+
+	if err != nil {
+		return err
+	}
+
+	for optionID, optionValueID := range attributes {
+		option, err := store.ProductOptionFindByID(ctx, optionID)
+
+		if err != nil {
+			return err
+		}
+
+		if option == nil || option.ProductID() != product.ID() {
+			return errors.New("product option " + optionID + " not found in product " + product.ID())
+		}
+
+		optionValue, err := store.ProductOptionValueFindByID(ctx, optionValueID)
+
+		if err != nil {
+			return err
+		}
+
+		if optionValue == nil || optionValue.OptionID() != optionID {
+			return errors.New("product option value " + optionValueID + " not found in option " + option.Title())
+		}
+	}
+
+	if productVariant.SKU() == "" {
+		return nil
+	}
+
+	existing, err := store.ProductVariantList(ctx, NewProductVariantQuery().
+		SetSKU(productVariant.SKU()).
+		SetLimit(2))
+
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range existing {
+		if variant.ID() != productVariant.ID() {
+			return errors.New("product variant SKU " + productVariant.SKU() + " already exists")
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) productVariantQuery(options ProductVariantQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewProductVariantQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.productVariantTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasIDIn() {
+		q = q.Where(goqu.C(COLUMN_ID).In(options.IDIn()))
+	}
+
+	if options.HasProductID() {
+		q = q.Where(goqu.C(COLUMN_PRODUCT_ID).Eq(options.ProductID()))
+	}
+
+	if options.HasSKU() {
+		q = q.Where(goqu.C(COLUMN_SKU).Eq(options.SKU()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted product variants requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
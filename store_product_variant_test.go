@@ -0,0 +1,185 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductVariantCreate(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("T-shirt").
+		SetPriceMoney(NewMoney(1500, "USD"))
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	size := NewProductOption().SetProductID(product.ID()).SetTitle("Size")
+
+	if err := store.ProductOptionCreate(ctx, size); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	large := NewProductOptionValue().SetOptionID(size.ID()).SetTitle("L").SetSequence(2)
+	small := NewProductOptionValue().SetOptionID(size.ID()).SetTitle("S").SetSequence(1)
+
+	for _, value := range []ProductOptionValueInterface{large, small} {
+		if err := store.ProductOptionValueCreate(ctx, value); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	values, err := store.ProductOptionValueList(ctx, size.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(values) != 2 || values[0].Title() != "S" {
+		t.Fatal("Option values MUST be listed in sequence, found:", values)
+	}
+
+	variant := NewProductVariant().
+		SetProductID(product.ID()).
+		SetSKU("TS-L").
+		SetTitle("T-shirt L").
+		SetPriceMoney(NewMoney(1700, "USD")).
+		SetQuantityInt(3)
+
+	if err := variant.SetAttributes(map[string]string{size.ID(): large.ID()}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductVariantCreate(ctx, variant); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	variantFound, err := store.ProductVariantFindByID(ctx, variant.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if variantFound == nil {
+		t.Fatal("Product variant MUST NOT be nil")
+	}
+
+	if !variantFound.HasPrice() || variantFound.PriceMoney().Amount() != 1700 {
+		t.Fatal("Product variant price MUST be 17.00, found:", variantFound.Price())
+	}
+
+	attributes, err := variantFound.Attributes()
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if attributes[size.ID()] != large.ID() {
+		t.Fatal("Product variant MUST have size L, found:", attributes)
+	}
+
+	duplicate := NewProductVariant().
+		SetProductID(product.ID()).
+		SetSKU("TS-L")
+
+	if err := store.ProductVariantCreate(ctx, duplicate); err == nil {
+		t.Fatal("Product variant with a duplicate SKU MUST NOT be created")
+	}
+
+	foreign := NewProductVariant().
+		SetProductID(product.ID()).
+		SetSKU("TS-X")
+
+	if err := foreign.SetAttributes(map[string]string{size.ID(): "UNKNOWN_VALUE_ID"}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductVariantCreate(ctx, foreign); err == nil {
+		t.Fatal("Product variant with an unknown option value MUST NOT be created")
+	}
+}
+
+func TestStorePlaceOrderWithVariant(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("T-shirt").
+		SetQuantityInt(10)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	variant := NewProductVariant().
+		SetProductID(product.ID()).
+		SetSKU("TS-M").
+		SetQuantityInt(2)
+
+	if err := store.ProductVariantCreate(ctx, variant); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	err = store.PlaceOrder(ctx, order, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetVariantID(variant.ID()).SetPriceFloat(15).SetQuantityInt(2),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	variantFound, err := store.ProductVariantFindByID(ctx, variant.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if variantFound.QuantityInt() != 0 {
+		t.Fatal("Product variant quantity MUST be 0, found:", variantFound.QuantityInt())
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 10 {
+		t.Fatal("Product quantity MUST stay 10, found:", productFound.QuantityInt())
+	}
+
+	lineItems, err := store.OrderLineItemList(ctx, NewOrderLineItemQuery().SetOrderID(order.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(lineItems) != 1 || lineItems[0].VariantID() != variant.ID() {
+		t.Fatal("Order line item MUST reference the variant, found:", lineItems)
+	}
+
+	err = store.PlaceOrder(ctx, NewOrder().SetCustomerID("CUSTOMER02_ID"), []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetVariantID(variant.ID()).SetPriceFloat(15).SetQuantityInt(1),
+	})
+
+	if err == nil {
+		t.Fatal("Placing an order for a variant out of stock MUST fail")
+	}
+}
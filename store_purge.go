@@ -0,0 +1,98 @@
+package shopstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+)
+
+// PurgeStale cleans up what checkouts leave behind:
+//   - pending orders created more than olderThan ago are cancelled, or soft
+//     deleted if the order status transitions do not allow cancelling them,
+//     and the stock taken or reserved for them is put back
+//   - active carts expired more than olderThan ago are marked as abandoned
+//     and soft deleted
+//   - active stock reservations past their expiry are marked as expired
+//
+// If olderThan is not positive, the stale timeout of the store is used
+// (see NewStoreOptions.StaleTimeoutSeconds). The report lists what was
+// touched, also when an error stops the purge part way.
+func (store *Store) PurgeStale(ctx context.Context, olderThan time.Duration) (PurgeReport, error) {
+	report := PurgeReport{
+		CancelledOrderIDs:     []string{},
+		SoftDeletedOrderIDs:   []string{},
+		AbandonedCartIDs:      []string{},
+		ExpiredReservationIDs: []string{},
+	}
+
+	if olderThan <= 0 {
+		olderThan = time.Duration(store.timeoutSeconds) * time.Second
+	}
+
+	cutoff := carbon.Now(carbon.UTC).SubSeconds(int(olderThan.Seconds())).ToDateTimeString(carbon.UTC)
+
+	orders, err := store.OrderList(ctx, NewOrderQuery().
+		SetStatus(ORDER_STATUS_PENDING).
+		SetCreatedAtLte(cutoff))
+
+	if err != nil {
+		return report, err
+	}
+
+	for _, order := range orders {
+		if store.OrderStatusTransitionAllowed(order.Status(), ORDER_STATUS_CANCELLED) {
+			if err := store.OrderTransition(ctx, order, ORDER_STATUS_CANCELLED); err != nil {
+				return report, err
+			}
+
+			report.CancelledOrderIDs = append(report.CancelledOrderIDs, order.ID())
+			continue
+		}
+
+		err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+			if err := store.OrderSoftDelete(txCtx, order); err != nil {
+				return err
+			}
+
+			return store.stockReservationsRelease(txCtx, order.ID())
+		})
+
+		if err != nil {
+			return report, err
+		}
+
+		report.SoftDeletedOrderIDs = append(report.SoftDeletedOrderIDs, order.ID())
+	}
+
+	carts, err := store.CartList(ctx, NewCartQuery().
+		SetStatus(CART_STATUS_ACTIVE).
+		SetExpiresAtLte(cutoff))
+
+	if err != nil {
+		return report, err
+	}
+
+	for _, cart := range carts {
+		// The status change is stored together with the soft delete
+		cart.SetStatus(CART_STATUS_ABANDONED)
+
+		if err := store.CartSoftDelete(ctx, cart); err != nil {
+			return report, err
+		}
+
+		report.AbandonedCartIDs = append(report.AbandonedCartIDs, cart.ID())
+	}
+
+	// Expired reservations no longer hold stock, this only records it
+	expiredIDs, err := store.stockReservationsExpire(ctx, carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	report.ExpiredReservationIDs = append(report.ExpiredReservationIDs, expiredIDs...)
+
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,218 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func TestStorePurgeStale(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	stale := NewOrder().
+		SetCustomerID("CUSTOMER01_ID").
+		SetCreatedAt(carbon.Now(carbon.UTC).SubHours(3).ToDateTimeString(carbon.UTC))
+
+	fresh := NewOrder().
+		SetCustomerID("CUSTOMER01_ID")
+
+	paid := NewOrder().
+		SetStatus(ORDER_STATUS_AWAITING_FULFILLMENT).
+		SetCustomerID("CUSTOMER01_ID").
+		SetCreatedAt(carbon.Now(carbon.UTC).SubHours(3).ToDateTimeString(carbon.UTC))
+
+	for _, order := range []OrderInterface{stale, fresh, paid} {
+		// OrderCreate sets the creation time, so it is set back afterwards
+		createdAt := order.CreatedAt()
+
+		if err := store.OrderCreate(ctx, order); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		order.SetCreatedAt(createdAt)
+
+		if err := store.OrderUpdate(ctx, order); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	expired := NewCart().
+		SetSessionID("SESSION01").
+		SetExpiresAt(carbon.Now(carbon.UTC).SubHours(3).ToDateTimeString(carbon.UTC))
+
+	active := NewCart().
+		SetSessionID("SESSION02")
+
+	for _, cart := range []CartInterface{expired, active} {
+		if err := store.CartCreate(ctx, cart); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	report, err := store.PurgeStale(ctx, 0)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(report.CancelledOrderIDs) != 1 || report.CancelledOrderIDs[0] != stale.ID() {
+		t.Fatal("Only the stale pending order MUST be cancelled, found:", report.CancelledOrderIDs)
+	}
+
+	if len(report.AbandonedCartIDs) != 1 || report.AbandonedCartIDs[0] != expired.ID() {
+		t.Fatal("Only the expired cart MUST be abandoned, found:", report.AbandonedCartIDs)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, stale.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !orderFound.IsCancelled() {
+		t.Fatal("Stale order MUST be cancelled, found:", orderFound.Status())
+	}
+
+	cartFound, err := store.CartFindByID(ctx, expired.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if cartFound != nil {
+		t.Fatal("Expired cart MUST be soft deleted")
+	}
+
+	// Nothing is stale any more, unless the age is shorter
+	report, err = store.PurgeStale(ctx, time.Hour)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(report.CancelledOrderIDs) != 0 || len(report.AbandonedCartIDs) != 0 {
+		t.Fatal("Nothing MUST be purged twice, found:", report)
+	}
+}
+
+func TestStorePurgeStaleRestock(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("T-shirt").
+		SetQuantityInt(5)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	size := NewProductOption().SetProductID(product.ID()).SetTitle("Size")
+
+	if err := store.ProductOptionCreate(ctx, size); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	large := NewProductOptionValue().SetOptionID(size.ID()).SetTitle("L")
+
+	if err := store.ProductOptionValueCreate(ctx, large); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	variant := NewProductVariant().
+		SetProductID(product.ID()).
+		SetSKU("TS-L").
+		SetTitle("T-shirt L").
+		SetQuantityInt(3)
+
+	if err := variant.SetAttributes(map[string]string{size.ID(): large.ID()}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ProductVariantCreate(ctx, variant); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	stale := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	err = store.PlaceOrder(ctx, stale, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetPriceFloat(10).SetQuantityInt(2),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	declined := NewOrder().SetCustomerID("CUSTOMER02_ID")
+
+	err = store.PlaceOrder(ctx, declined, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetVariantID(variant.ID()).SetPriceFloat(12).SetQuantityInt(1),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	stale.SetCreatedAt(carbon.Now(carbon.UTC).SubHours(3).ToDateTimeString(carbon.UTC))
+
+	if err := store.OrderUpdate(ctx, stale); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.PurgeStale(ctx, time.Hour); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 5 {
+		t.Fatal("Product quantity MUST be put back to 5 when the stale order is cancelled, found:", productFound.QuantityInt())
+	}
+
+	if err := store.OrderTransition(ctx, declined, ORDER_STATUS_DECLINED); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	variantFound, err := store.ProductVariantFindByID(ctx, variant.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if variantFound.QuantityInt() != 3 {
+		t.Fatal("Variant quantity MUST be put back to 3 when the order is declined, found:", variantFound.QuantityInt())
+	}
+
+	// Cancelling again does not put the stock back twice
+	if _, err := store.PurgeStale(ctx, time.Hour); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err = store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 5 {
+		t.Fatal("Product quantity MUST stay 5, found:", productFound.QuantityInt())
+	}
+}
@@ -0,0 +1,333 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) RefundCount(ctx context.Context, options RefundQueryInterface) (int64, error) {
+	q, _, err := store.refundQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+// RefundCreate records a refund of the order.
+//
+// The refunds of an order cannot add up to more than its captured payments,
+// and the refunds of a line item cannot add up to more than the line item
+// total. Refunds of the same order are recorded one after the other.
+// Once recorded, the order is moved to refunded if it has been refunded in
+// full, or to partially refunded otherwise. If the order status does not
+// allow this, nothing is recorded and an *OrderStatusTransitionError is returned.
+func (store *Store) RefundCreate(ctx context.Context, refund RefundInterface) error {
+	if refund == nil {
+		return errors.New("refund is nil")
+	}
+
+	if refund.OrderID() == "" {
+		return errors.New("refund order id is empty")
+	}
+
+	if !refund.AmountMoney().IsPositive() {
+		return errors.New("refund amount must be positive")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		// Refunds of the same order are checked one after the other
+		if err := store.orderLock(txCtx, refund.OrderID()); err != nil {
+			return err
+		}
+
+		order, err := store.OrderFindByID(txCtx, refund.OrderID())
+
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return errors.New("order not found")
+		}
+
+		if refund.Currency() != order.Currency() {
+			return errors.New("refund currency " + refund.Currency() + " does not match the order currency " + order.Currency())
+		}
+
+		if refund.OrderLineItemID() != "" {
+			lineItem, err := store.OrderLineItemFindByID(txCtx, refund.OrderLineItemID())
+
+			if err != nil {
+				return err
+			}
+
+			if lineItem == nil || lineItem.OrderID() != order.ID() {
+				return errors.New("order line item " + refund.OrderLineItemID() + " not found in order " + order.ID())
+			}
+
+			if lineItem.Currency() != order.Currency() {
+				return errors.New("order line item currency " + lineItem.Currency() + " does not match the order currency " + order.Currency())
+			}
+
+			lineItemRefunded, err := store.refundTotal(txCtx, order, refund.OrderLineItemID())
+
+			if err != nil {
+				return err
+			}
+
+			lineItemTotal := lineItem.PriceMoney().Mul(lineItem.QuantityInt())
+
+			if lineItemRefunded.Add(refund.AmountMoney()).Cmp(lineItemTotal) > 0 {
+				return errors.New("refund exceeds the line item total, " + lineItemTotal.Sub(lineItemRefunded).String() + " left to refund")
+			}
+		}
+
+		refunded, err := store.refundTotal(txCtx, order, "")
+
+		if err != nil {
+			return err
+		}
+
+		paid, err := store.paymentCapturedTotal(txCtx, order)
+
+		if err != nil {
+			return err
+		}
+
+		refunded = refunded.Add(refund.AmountMoney())
+
+		if refunded.Cmp(paid) > 0 {
+			return errors.New("refund exceeds the amount paid, " + paid.Sub(refunded.Sub(refund.AmountMoney())).String() + " left to refund")
+		}
+
+		if err := store.refundInsert(txCtx, refund); err != nil {
+			return err
+		}
+
+		status := lo.Ternary(refunded.Cmp(paid) >= 0, ORDER_STATUS_REFUNDED, ORDER_STATUS_PARTIALLY_REFUNDED)
+
+		if order.Status() == status {
+			return nil
+		}
+
+		return store.OrderTransition(txCtx, order, status)
+	})
+}
+
+func (store *Store) RefundFindByID(ctx context.Context, id string) (RefundInterface, error) {
+	if id == "" {
+		return nil, errors.New("refund id is empty")
+	}
+
+	list, err := store.RefundList(ctx, NewRefundQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) RefundList(ctx context.Context, options RefundQueryInterface) ([]RefundInterface, error) {
+	q, columns, err := store.refundQuery(options)
+
+	if err != nil {
+		return []RefundInterface{}, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []RefundInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []RefundInterface{}, err
+	}
+
+	list := []RefundInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewRefundFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+// RefundTotal returns the total amount refunded for the order
+func (store *Store) RefundTotal(ctx context.Context, orderID string) (Money, error) {
+	if orderID == "" {
+		return Money{}, errors.New("order id is empty")
+	}
+
+	order, err := store.OrderFindByID(ctx, orderID)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	if order == nil {
+		return Money{}, errors.New("order not found")
+	}
+
+	return store.refundTotal(ctx, order, "")
+}
+
+func (store *Store) refundInsert(ctx context.Context, refund RefundInterface) error {
+	refund.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	data := refund.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.refundTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	refund.MarkAsNotDirty()
+
+	return nil
+}
+
+// refundTotal returns the total amount refunded for the order,
+// in the order currency, or only for the line item with the given ID if
+// not empty
+func (store *Store) refundTotal(ctx context.Context, order OrderInterface, orderLineItemID string) (Money, error) {
+	query := NewRefundQuery().SetOrderID(order.ID())
+
+	if orderLineItemID != "" {
+		query.SetOrderLineItemID(orderLineItemID)
+	}
+
+	refunds, err := store.RefundList(ctx, query)
+
+	if err != nil {
+		return NewMoney(0, order.Currency()), err
+	}
+
+	total := NewMoney(0, order.Currency())
+
+	for _, refund := range refunds {
+		if refund.Currency() == order.Currency() {
+			total = total.Add(refund.AmountMoney())
+		}
+	}
+
+	return total, nil
+}
+
+func (store *Store) refundQuery(options RefundQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		return nil, nil, errors.New("refund options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.refundTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasOrderID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Eq(options.OrderID()))
+	}
+
+	if options.HasOrderLineItemID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_LINE_ITEM_ID).Eq(options.OrderLineItemID()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	return q, columns, nil
+}
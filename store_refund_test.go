@@ -0,0 +1,139 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreRefundCreate(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_AWAITING_FULFILLMENT).
+		SetCustomerID("CUSTOMER01_ID").
+		SetGrandTotalMoney(NewMoney(3000, "USD"))
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Only captured payments can be refunded
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetAmountMoney(NewMoney(500, "USD")))
+
+	if err == nil {
+		t.Fatal("expected error, nothing has been paid for the order")
+	}
+
+	payment := NewPayment().
+		SetOrderID(order.ID()).
+		SetProvider("stripe").
+		SetAmountMoney(NewMoney(3000, "USD")).
+		SetStatus(PAYMENT_STATUS_CAPTURED)
+
+	if err := store.PaymentCreate(ctx, payment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	lineItem := NewOrderLineItem().
+		SetOrderID(order.ID()).
+		SetProductID("PRODUCT01_ID").
+		SetPriceMoney(NewMoney(1000, "USD")).
+		SetQuantityInt(2)
+
+	if err := store.OrderLineItemCreate(ctx, lineItem); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetOrderLineItemID(lineItem.ID()).
+		SetAmountMoney(NewMoney(500, "USD")).
+		SetReason("Damaged"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_PARTIALLY_REFUNDED {
+		t.Fatal("Order status MUST be partially_refunded, found:", orderFound.Status())
+	}
+
+	// Only 15.00 of the line item is left to refund
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetOrderLineItemID(lineItem.ID()).
+		SetAmountMoney(NewMoney(2000, "USD")))
+
+	if err == nil {
+		t.Fatal("expected error, the refund exceeds the line item total")
+	}
+
+	// Only 25.00 of the order is left to refund
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetAmountMoney(NewMoney(2600, "USD")))
+
+	if err == nil {
+		t.Fatal("expected error, the refund exceeds the amount paid")
+	}
+
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetAmountMoney(NewMoney(2500, "EUR")))
+
+	if err == nil {
+		t.Fatal("expected error, the refund is in another currency")
+	}
+
+	err = store.RefundCreate(ctx, NewRefund().
+		SetOrderID(order.ID()).
+		SetAmountMoney(NewMoney(2500, "USD")))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err = store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_REFUNDED {
+		t.Fatal("Order status MUST be refunded, found:", orderFound.Status())
+	}
+
+	total, err := store.RefundTotal(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if total.Amount() != 3000 {
+		t.Fatal("Refund total MUST be 30.00, found:", total.String())
+	}
+
+	count, err := store.RefundCount(ctx, NewRefundQuery().SetOrderLineItemID(lineItem.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Refund count for the line item MUST be 1, found:", count)
+	}
+}
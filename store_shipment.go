@@ -0,0 +1,503 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) ShipmentCount(ctx context.Context, options ShipmentQueryInterface) (int64, error) {
+	q, _, err := store.shipmentQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+// ShipmentCreate records a shipment of the order together with its lines,
+// the quantities of the order line items sent with it.
+//
+// A line cannot send more of a line item than is left to ship. Once stored,
+// the order is moved to shipped if all its line items have been shipped in
+// full, or to partially shipped otherwise. If the order status does not
+// allow this, nothing is stored and an *OrderStatusTransitionError is returned.
+// Shipments of the same order are stored one after the other.
+func (store *Store) ShipmentCreate(ctx context.Context, shipment ShipmentInterface, lines []ShipmentLineInterface) error {
+	if shipment == nil {
+		return errors.New("shipment is nil")
+	}
+
+	if shipment.OrderID() == "" {
+		return errors.New("shipment order id is empty")
+	}
+
+	if len(lines) < 1 {
+		return errors.New("shipment has no lines")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		// Shipments of the same order are checked one after the other
+		if err := store.orderLock(txCtx, shipment.OrderID()); err != nil {
+			return err
+		}
+
+		order, err := store.OrderFindByID(txCtx, shipment.OrderID())
+
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return errors.New("order not found")
+		}
+
+		lineItems, err := store.OrderLineItemList(txCtx, NewOrderLineItemQuery().SetOrderID(order.ID()))
+
+		if err != nil {
+			return err
+		}
+
+		shipped, err := store.orderShippedQuantities(txCtx, order.ID())
+
+		if err != nil {
+			return err
+		}
+
+		lineItemQuantities := map[string]int64{}
+
+		for _, lineItem := range lineItems {
+			lineItemQuantities[lineItem.ID()] = lineItem.QuantityInt()
+		}
+
+		for _, line := range lines {
+			quantity, exists := lineItemQuantities[line.OrderLineItemID()]
+
+			if !exists {
+				return errors.New("order line item " + line.OrderLineItemID() + " not found in order " + order.ID())
+			}
+
+			if line.QuantityInt() < 1 {
+				return errors.New("shipment line quantity must be positive")
+			}
+
+			if shipped[line.OrderLineItemID()]+line.QuantityInt() > quantity {
+				return errors.New("order line item " + line.OrderLineItemID() + " has only " + cast.ToString(quantity-shipped[line.OrderLineItemID()]) + " left to ship")
+			}
+
+			shipped[line.OrderLineItemID()] += line.QuantityInt()
+		}
+
+		if err := store.shipmentInsert(txCtx, shipment); err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			line.SetShipmentID(shipment.ID())
+
+			if err := store.shipmentLineInsert(txCtx, line); err != nil {
+				return err
+			}
+		}
+
+		allShipped := lo.EveryBy(lineItems, func(lineItem OrderLineItemInterface) bool {
+			return shipped[lineItem.ID()] >= lineItem.QuantityInt()
+		})
+
+		status := lo.Ternary(allShipped, ORDER_STATUS_SHIPPED, ORDER_STATUS_PARTIALLY_SHIPPED)
+
+		if order.Status() == status {
+			return nil
+		}
+
+		return store.OrderTransition(txCtx, order, status)
+	})
+}
+
+// ShipmentDelete deletes the shipment together with its lines
+func (store *Store) ShipmentDelete(ctx context.Context, shipment ShipmentInterface) error {
+	if shipment == nil {
+		return errors.New("shipment is nil")
+	}
+
+	return store.ShipmentDeleteByID(ctx, shipment.ID())
+}
+
+// ShipmentDeleteByID deletes the shipment together with its lines
+func (store *Store) ShipmentDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("shipment id is empty")
+	}
+
+	return store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			Delete(store.shipmentLineTableName).
+			Prepared(true).
+			Where(goqu.C(COLUMN_SHIPMENT_ID).Eq(id)).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("delete", sqlStr, params...)
+
+		if _, err := database.Execute(txCtx, sqlStr, params...); err != nil {
+			return err
+		}
+
+		sqlStr, params, errSql = goqu.Dialect(store.dbDriverName).
+			Delete(store.shipmentTableName).
+			Prepared(true).
+			Where(goqu.C(COLUMN_ID).Eq(id)).
+			ToSQL()
+
+		if errSql != nil {
+			return errSql
+		}
+
+		store.logSql("delete", sqlStr, params...)
+
+		_, err := database.Execute(txCtx, sqlStr, params...)
+
+		return err
+	})
+}
+
+func (store *Store) ShipmentFindByID(ctx context.Context, id string) (ShipmentInterface, error) {
+	if id == "" {
+		return nil, errors.New("shipment id is empty")
+	}
+
+	list, err := store.ShipmentList(ctx, NewShipmentQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) ShipmentList(ctx context.Context, options ShipmentQueryInterface) ([]ShipmentInterface, error) {
+	q, columns, err := store.shipmentQuery(options)
+
+	if err != nil {
+		return []ShipmentInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []ShipmentInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []ShipmentInterface{}, err
+	}
+
+	list := []ShipmentInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewShipmentFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+// ShipmentSoftDelete soft deletes the shipment. Its lines no longer count
+// as shipped, so the line items can be shipped again, e.g. when the parcel
+// was lost. The order status is left as it is.
+func (store *Store) ShipmentSoftDelete(ctx context.Context, shipment ShipmentInterface) error {
+	if shipment == nil {
+		return errors.New("shipment is nil")
+	}
+
+	shipment.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.ShipmentUpdate(ctx, shipment)
+}
+
+func (store *Store) ShipmentSoftDeleteByID(ctx context.Context, id string) error {
+	shipment, err := store.ShipmentFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.ShipmentSoftDelete(ctx, shipment)
+}
+
+// ShipmentUpdate updates the shipment details, i.e. the carrier and the
+// tracking number. The lines of a shipment cannot be changed.
+func (store *Store) ShipmentUpdate(ctx context.Context, shipment ShipmentInterface) error {
+	if shipment == nil {
+		return errors.New("shipment is nil")
+	}
+
+	shipment.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := shipment.DataChanged()
+
+	delete(dataChanged, COLUMN_ID)       // ID is not updateable
+	delete(dataChanged, COLUMN_ORDER_ID) // Order ID is not updateable
+	delete(dataChanged, "hash")          // Hash is not updateable
+	delete(dataChanged, "data")          // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.shipmentTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C(COLUMN_ID).Eq(shipment.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	shipment.MarkAsNotDirty()
+
+	return err
+}
+
+// ShipmentLineList returns the lines of the shipment
+func (store *Store) ShipmentLineList(ctx context.Context, shipmentID string) ([]ShipmentLineInterface, error) {
+	if shipmentID == "" {
+		return []ShipmentLineInterface{}, errors.New("shipment id is empty")
+	}
+
+	return store.shipmentLineSelect(ctx, []string{shipmentID})
+}
+
+func (store *Store) shipmentInsert(ctx context.Context, shipment ShipmentInterface) error {
+	shipment.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	shipment.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	shipment.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := shipment.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.shipmentTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	shipment.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) shipmentLineInsert(ctx context.Context, line ShipmentLineInterface) error {
+	line.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	data := line.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.shipmentLineTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	line.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) shipmentLineSelect(ctx context.Context, shipmentIDs []string) ([]ShipmentLineInterface, error) {
+	if len(shipmentIDs) < 1 {
+		return []ShipmentLineInterface{}, nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.shipmentLineTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_SHIPMENT_ID).In(shipmentIDs)).
+		Order(goqu.C(COLUMN_CREATED_AT).Asc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []ShipmentLineInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []ShipmentLineInterface{}, err
+	}
+
+	list := []ShipmentLineInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		list = append(list, NewShipmentLineFromExistingData(modelMap))
+	})
+
+	return list, nil
+}
+
+// orderShippedQuantities returns the quantities of the order line items
+// shipped so far, by order line item ID
+func (store *Store) orderShippedQuantities(ctx context.Context, orderID string) (map[string]int64, error) {
+	shipments, err := store.ShipmentList(ctx, NewShipmentQuery().
+		SetOrderID(orderID).
+		SetColumns([]string{COLUMN_ID}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentIDs := lo.Map(shipments, func(shipment ShipmentInterface, index int) string {
+		return shipment.ID()
+	})
+
+	lines, err := store.shipmentLineSelect(ctx, shipmentIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	shipped := map[string]int64{}
+
+	for _, line := range lines {
+		shipped[line.OrderLineItemID()] += line.QuantityInt()
+	}
+
+	return shipped, nil
+}
+
+func (store *Store) shipmentQuery(options ShipmentQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewShipmentQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.shipmentTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasOrderID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Eq(options.OrderID()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted shipments requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
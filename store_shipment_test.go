@@ -0,0 +1,189 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreShipmentCreate(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	order := NewOrder().
+		SetStatus(ORDER_STATUS_PENDING).
+		SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	book := NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT01_ID").SetQuantityInt(2)
+	pen := NewOrderLineItem().SetOrderID(order.ID()).SetProductID("PRODUCT02_ID").SetQuantityInt(1)
+
+	for _, lineItem := range []OrderLineItemInterface{book, pen} {
+		if err := store.OrderLineItemCreate(ctx, lineItem); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	shipment := NewShipment().
+		SetOrderID(order.ID()).
+		SetCarrier("DHL").
+		SetTrackingNumber("TRACK01")
+
+	// A pending order cannot be shipped
+	err = store.ShipmentCreate(ctx, shipment, []ShipmentLineInterface{
+		NewShipmentLine().SetOrderLineItemID(book.ID()).SetQuantityInt(1),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, a pending order cannot be shipped")
+	}
+
+	count, err := store.ShipmentCount(ctx, NewShipmentQuery().SetOrderID(order.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 0 {
+		t.Fatal("Shipment MUST NOT be stored when the order cannot be shipped, found:", count)
+	}
+
+	if err := store.OrderTransition(ctx, order, ORDER_STATUS_AWAITING_FULFILLMENT); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	err = store.ShipmentCreate(ctx, shipment, []ShipmentLineInterface{
+		NewShipmentLine().SetOrderLineItemID(book.ID()).SetQuantityInt(1),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err := store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_PARTIALLY_SHIPPED {
+		t.Fatal("Order status MUST be partially_shipped, found:", orderFound.Status())
+	}
+
+	lines, err := store.ShipmentLineList(ctx, shipment.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(lines) != 1 || lines[0].QuantityInt() != 1 {
+		t.Fatal("Shipment MUST have 1 line with quantity 1, found:", len(lines))
+	}
+
+	// Only 1 book is left to ship
+	err = store.ShipmentCreate(ctx, NewShipment().SetOrderID(order.ID()), []ShipmentLineInterface{
+		NewShipmentLine().SetOrderLineItemID(book.ID()).SetQuantityInt(2),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, only 1 book is left to ship")
+	}
+
+	err = store.ShipmentCreate(ctx, NewShipment().SetOrderID(order.ID()), []ShipmentLineInterface{
+		NewShipmentLine().SetOrderLineItemID("UNKNOWN_ID").SetQuantityInt(1),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, the line item is not in the order")
+	}
+
+	err = store.ShipmentCreate(ctx, NewShipment().SetOrderID(order.ID()), []ShipmentLineInterface{
+		NewShipmentLine().SetOrderLineItemID(book.ID()).SetQuantityInt(1),
+		NewShipmentLine().SetOrderLineItemID(pen.ID()).SetQuantityInt(1),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	orderFound, err = store.OrderFindByID(ctx, order.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if orderFound.Status() != ORDER_STATUS_SHIPPED {
+		t.Fatal("Order status MUST be shipped, found:", orderFound.Status())
+	}
+
+	count, err = store.ShipmentCount(ctx, NewShipmentQuery().SetOrderID(order.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 2 {
+		t.Fatal("Shipment count MUST be 2, found:", count)
+	}
+
+	shipment.SetTrackingNumber("TRACK02")
+
+	if err := store.ShipmentUpdate(ctx, shipment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	shipmentFound, err := store.ShipmentFindByID(ctx, shipment.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if shipmentFound == nil || shipmentFound.TrackingNumber() != "TRACK02" {
+		t.Fatal("Shipment tracking number MUST be TRACK02")
+	}
+
+	if err := store.ShipmentSoftDelete(ctx, shipment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	count, err = store.ShipmentCount(ctx, NewShipmentQuery().SetOrderID(order.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Shipment count MUST be 1 after the soft delete, found:", count)
+	}
+
+	if err := store.ShipmentDelete(ctx, shipment); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	count, err = store.ShipmentCount(ctx, NewShipmentQuery().SetOrderID(order.ID()).SetSoftDeletedIncluded(true))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Shipment count MUST be 1 after the delete, found:", count)
+	}
+
+	lines, err = store.ShipmentLineList(ctx, shipment.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(lines) != 0 {
+		t.Fatal("Shipment lines MUST be deleted with the shipment, found:", len(lines))
+	}
+}
@@ -0,0 +1,299 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+func (store *Store) ShippingMethodCount(ctx context.Context, options ShippingMethodQueryInterface) (int64, error) {
+	q, _, err := store.shippingMethodQuery(options.SetCountOnly(true))
+
+	if err != nil {
+		return -1, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).
+		Limit(1).
+		Select(goqu.COUNT(goqu.Star()).As("count")).
+		ToSQL()
+
+	if errSql != nil {
+		return -1, nil
+	}
+
+	store.logSql("count", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return -1, err
+	}
+
+	if len(mapped) < 1 {
+		return -1, nil
+	}
+
+	countStr := mapped[0]["count"]
+
+	i, err := strconv.ParseInt(countStr, 10, 64)
+
+	if err != nil {
+		return -1, err
+
+	}
+
+	return i, nil
+}
+
+func (store *Store) ShippingMethodCreate(ctx context.Context, shippingMethod ShippingMethodInterface) error {
+	shippingMethod.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	shippingMethod.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	shippingMethod.SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	data := shippingMethod.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.shippingMethodTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	shippingMethod.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) ShippingMethodDelete(ctx context.Context, shippingMethod ShippingMethodInterface) error {
+	if shippingMethod == nil {
+		return errors.New("shipping method is nil")
+	}
+
+	return store.ShippingMethodDeleteByID(ctx, shippingMethod.ID())
+}
+
+func (store *Store) ShippingMethodDeleteByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("shipping method id is empty")
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Delete(store.shippingMethodTableName).
+		Prepared(true).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("delete", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) ShippingMethodFindByID(ctx context.Context, id string) (ShippingMethodInterface, error) {
+	if id == "" {
+		return nil, errors.New("shipping method id is empty")
+	}
+
+	list, err := store.ShippingMethodList(ctx, NewShippingMethodQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) ShippingMethodList(ctx context.Context, options ShippingMethodQueryInterface) ([]ShippingMethodInterface, error) {
+	q, columns, err := store.shippingMethodQuery(options)
+
+	if err != nil {
+		return []ShippingMethodInterface{}, err
+	}
+
+	sqlStr, sqlParams, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []ShippingMethodInterface{}, nil
+	}
+
+	store.logSql("select", sqlStr, sqlParams...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, sqlParams...)
+
+	if err != nil {
+		return []ShippingMethodInterface{}, err
+	}
+
+	list := []ShippingMethodInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewShippingMethodFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+func (store *Store) ShippingMethodSoftDelete(ctx context.Context, shippingMethod ShippingMethodInterface) error {
+	if shippingMethod == nil {
+		return errors.New("shipping method is nil")
+	}
+
+	shippingMethod.SetSoftDeletedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return store.ShippingMethodUpdate(ctx, shippingMethod)
+}
+
+func (store *Store) ShippingMethodSoftDeleteByID(ctx context.Context, id string) error {
+	shippingMethod, err := store.ShippingMethodFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	return store.ShippingMethodSoftDelete(ctx, shippingMethod)
+}
+
+func (store *Store) ShippingMethodUpdate(ctx context.Context, shippingMethod ShippingMethodInterface) error {
+	if shippingMethod == nil {
+		return errors.New("shipping method is nil")
+	}
+
+	shippingMethod.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	dataChanged := shippingMethod.DataChanged()
+
+	delete(dataChanged, COLUMN_ID) // ID is not updateable
+	delete(dataChanged, "hash")    // Hash is not updateable
+	delete(dataChanged, "data")    // Data is not updateable
+
+	if len(dataChanged) < 1 {
+		return nil
+	}
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.shippingMethodTableName).
+		Prepared(true).
+		Set(dataChanged).
+		Where(goqu.C("id").Eq(shippingMethod.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	shippingMethod.MarkAsNotDirty()
+
+	return err
+}
+
+func (store *Store) shippingMethodQuery(options ShippingMethodQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		options = NewShippingMethodQuery()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.shippingMethodTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasIDIn() {
+		q = q.Where(goqu.C(COLUMN_ID).In(options.IDIn()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if options.HasStatusIn() {
+		q = q.Where(goqu.C(COLUMN_STATUS).In(options.StatusIn()))
+	}
+
+	if options.HasType() {
+		q = q.Where(goqu.C(COLUMN_TYPE).Eq(options.Type()))
+	}
+
+	if options.HasCreatedAtGte() && options.HasCreatedAtLte() {
+		q = q.Where(goqu.C(COLUMN_CREATED_AT).Between(exp.NewRangeVal(options.CreatedAtGte(), options.CreatedAtLte())))
+	} else if options.HasCreatedAtGte() {
+		q = q.Where(goqu.C(COLUMN_CREATED_AT).Gte(options.CreatedAtGte()))
+	} else if options.HasCreatedAtLte() {
+		q = q.Where(goqu.C(COLUMN_CREATED_AT).Lte(options.CreatedAtLte()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	if options.SoftDeletedIncluded() {
+		return q, columns, nil // soft deleted shipping methods requested specifically
+	}
+
+	softDeleted := goqu.C(COLUMN_SOFT_DELETED_AT).
+		Gt(carbon.Now(carbon.UTC).ToDateTimeString())
+
+	return q.Where(softDeleted), columns, nil
+}
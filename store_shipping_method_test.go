@@ -0,0 +1,172 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreShippingMethodCreateAndFind(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	shippingMethod := NewShippingMethod().
+		SetStatus(SHIPPING_METHOD_STATUS_ACTIVE).
+		SetType(SHIPPING_METHOD_TYPE_WEIGHT).
+		SetTitle("Parcel")
+
+	err = shippingMethod.SetRateBands([]ShippingRateBand{
+		{From: "0", Price: "3.50"},
+		{From: "2", Price: "7.00"},
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.ShippingMethodCreate(ctx, shippingMethod); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	shippingMethodFound, err := store.ShippingMethodFindByID(ctx, shippingMethod.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if shippingMethodFound == nil {
+		t.Fatal("Shipping method MUST NOT be nil")
+	}
+
+	bands, err := shippingMethodFound.RateBands()
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(bands) != 2 || bands[1].Price != "7.00" {
+		t.Fatal("Shipping method MUST have 2 rate bands, found:", bands)
+	}
+
+	count, err := store.ShippingMethodCount(ctx, NewShippingMethodQuery().SetType(SHIPPING_METHOD_TYPE_WEIGHT))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 1 {
+		t.Fatal("Shipping method count MUST be 1, found:", count)
+	}
+}
+
+func TestStoreShippingQuote(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	flat := NewShippingMethod().
+		SetStatus(SHIPPING_METHOD_STATUS_ACTIVE).
+		SetTitle("Standard").
+		SetPriceMoney(NewMoney(499, "USD")).
+		SetFreeShippingThresholdMoney(NewMoney(5000, "USD"))
+
+	weight := NewShippingMethod().
+		SetStatus(SHIPPING_METHOD_STATUS_ACTIVE).
+		SetType(SHIPPING_METHOD_TYPE_WEIGHT).
+		SetTitle("Parcel")
+
+	banded := NewShippingMethod().
+		SetStatus(SHIPPING_METHOD_STATUS_ACTIVE).
+		SetType(SHIPPING_METHOD_TYPE_PRICE).
+		SetTitle("Courier")
+
+	inactive := NewShippingMethod().
+		SetStatus(SHIPPING_METHOD_STATUS_INACTIVE).
+		SetTitle("Pigeon")
+
+	if err := weight.SetRateBands([]ShippingRateBand{{From: "0", Price: "3.00"}, {From: "1.5", Price: "6.00"}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Only ships orders of 20.00 or more
+	if err := banded.SetRateBands([]ShippingRateBand{{From: "20", Price: "9.00"}, {From: "100", Price: "0"}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, method := range []ShippingMethodInterface{flat, weight, banded, inactive} {
+		if err := store.ShippingMethodCreate(ctx, method); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	book := NewProduct().SetTitle("Book").SetWeightFloat(0.8)
+
+	if err := store.ProductCreate(ctx, book); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(book.ID()).SetPriceFloat(12.5).SetQuantityInt(2),
+	}
+
+	quotes, err := store.ShippingQuote(ctx, order, lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// 1.6kg, 25.00
+	expected := map[string]int64{"Standard": 499, "Parcel": 600, "Courier": 900}
+
+	if len(quotes) != len(expected) {
+		t.Fatal("Shipping quotes MUST be", len(expected), "found:", len(quotes))
+	}
+
+	for _, quote := range quotes {
+		if quote.Price.Amount() != expected[quote.ShippingMethod.Title()] {
+			t.Fatal("Shipping quote for", quote.ShippingMethod.Title(), "MUST be", expected[quote.ShippingMethod.Title()], "found:", quote.Price.Amount())
+		}
+	}
+
+	if quotes[0].ShippingMethod.Title() != "Standard" {
+		t.Fatal("Cheapest shipping quote MUST be first, found:", quotes[0].ShippingMethod.Title())
+	}
+
+	// 1 item: 0.8kg, 12.50, the courier does not ship it
+	lineItems[0].SetQuantityInt(1)
+
+	quotes, err = store.ShippingQuote(ctx, order, lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, quote := range quotes {
+		if quote.ShippingMethod.Title() == "Courier" {
+			t.Fatal("Courier MUST NOT ship orders below 20.00")
+		}
+	}
+
+	// Free shipping over 50.00
+	lineItems[0].SetQuantityInt(4)
+
+	quotes, err = store.ShippingQuote(ctx, order, lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if quotes[0].ShippingMethod.Title() != "Standard" || !quotes[0].Price.IsZero() {
+		t.Fatal("Standard shipping MUST be free, found:", quotes[0].ShippingMethod.Title(), quotes[0].Price.String())
+	}
+}
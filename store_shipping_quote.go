@@ -0,0 +1,94 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// ShippingQuote returns the active shipping methods that can ship the order,
+// each with its price for the order, cheapest first.
+//
+// Weight based methods use the weights of the line item products, price
+// banded methods and free shipping thresholds use the line items subtotal.
+func (store *Store) ShippingQuote(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) ([]ShippingMethodQuote, error) {
+	if order == nil {
+		return nil, errors.New("order is nil")
+	}
+
+	subtotal, weightGrams, err := store.shippingMeasure(ctx, order, lineItems)
+
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := store.ShippingMethodList(ctx, NewShippingMethodQuery().
+		SetStatus(SHIPPING_METHOD_STATUS_ACTIVE))
+
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := []ShippingMethodQuote{}
+
+	for _, method := range methods {
+		price, available := method.Quote(subtotal, weightGrams)
+
+		if !available {
+			continue
+		}
+
+		quotes = append(quotes, ShippingMethodQuote{
+			ShippingMethod: method,
+			Price:          price,
+		})
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].Price.Cmp(quotes[j].Price) < 0
+	})
+
+	return quotes, nil
+}
+
+// shippingMeasure returns the subtotal and the total weight in grams of the line items
+func (store *Store) shippingMeasure(ctx context.Context, order OrderInterface, lineItems []OrderLineItemInterface) (subtotal Money, weightGrams int64, err error) {
+	subtotal = NewMoney(0, order.Currency())
+	productWeights := map[string]int64{}
+
+	for _, lineItem := range lineItems {
+		if lineItem.Currency() != order.Currency() {
+			return subtotal, 0, errors.New("order line item currency " + lineItem.Currency() + " does not match the order currency " + order.Currency())
+		}
+
+		subtotal = subtotal.Add(lineItem.PriceMoney().Mul(lineItem.QuantityInt()))
+
+		if lineItem.ProductID() == "" {
+			continue
+		}
+
+		weight, cached := productWeights[lineItem.ProductID()]
+
+		if !cached {
+			product, err := store.ProductFindByID(ctx, lineItem.ProductID())
+
+			if err != nil {
+				return subtotal, 0, err
+			}
+
+			if product != nil {
+				weight, err = decimalParse(product.Weight(), WEIGHT_DECIMALS)
+
+				if err != nil {
+					return subtotal, 0, err
+				}
+			}
+
+			productWeights[lineItem.ProductID()] = weight
+		}
+
+		weightGrams += weight * lineItem.QuantityInt()
+	}
+
+	return subtotal, weightGrams, nil
+}
@@ -0,0 +1,190 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/strutils"
+	"github.com/samber/lo"
+)
+
+// SlugRedirectList returns the previous slugs of the product or category
+// (see SLUG_REDIRECT_ENTITY_TYPE_PRODUCT), the most recent first
+func (store *Store) SlugRedirectList(ctx context.Context, entityType string, entityID string) ([]SlugRedirectInterface, error) {
+	if entityType == "" {
+		return []SlugRedirectInterface{}, errors.New("slug redirect entity type is empty")
+	}
+
+	if entityID == "" {
+		return []SlugRedirectInterface{}, errors.New("slug redirect entity id is empty")
+	}
+
+	return store.slugRedirectSelect(ctx, goqu.And(
+		goqu.C(COLUMN_ENTITY_TYPE).Eq(entityType),
+		goqu.C(COLUMN_ENTITY_ID).Eq(entityID),
+	))
+}
+
+// slugChange returns the unique slug for the row with the ID in the table,
+// and keeps the slug stored for the row before as a redirect to it
+func (store *Store) slugChange(ctx context.Context, tableName string, entityType string, id string, slug string) (string, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(tableName).
+		Prepared(true).
+		Select(COLUMN_SLUG).
+		Where(goqu.C(COLUMN_ID).Eq(id)).
+		Limit(1).
+		ToSQL()
+
+	if errSql != nil {
+		return "", errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return "", err
+	}
+
+	slug, err = store.slugUnique(ctx, tableName, id, slug)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(mapped) < 1 || mapped[0][COLUMN_SLUG] == "" || mapped[0][COLUMN_SLUG] == slug {
+		return slug, nil
+	}
+
+	redirect := NewSlugRedirect().
+		SetEntityType(entityType).
+		SetEntityID(id).
+		SetSlug(mapped[0][COLUMN_SLUG])
+
+	if err := store.slugRedirectInsert(ctx, redirect); err != nil {
+		return "", err
+	}
+
+	return slug, nil
+}
+
+// slugRedirectEntityID returns the ID of the product or category the slug
+// most recently redirects to, or an empty string if there is none
+func (store *Store) slugRedirectEntityID(ctx context.Context, entityType string, slug string) (string, error) {
+	list, err := store.slugRedirectSelect(ctx, goqu.And(
+		goqu.C(COLUMN_ENTITY_TYPE).Eq(entityType),
+		goqu.C(COLUMN_SLUG).Eq(slug),
+	))
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(list) < 1 {
+		return "", nil
+	}
+
+	return list[0].EntityID(), nil
+}
+
+func (store *Store) slugRedirectInsert(ctx context.Context, redirect SlugRedirectInterface) error {
+	redirect.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.slugRedirectTableName).
+		Prepared(true).
+		Rows(redirect.Data()).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	redirect.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) slugRedirectSelect(ctx context.Context, where goqu.Expression) ([]SlugRedirectInterface, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.slugRedirectTableName).
+		Prepared(true).
+		Where(where).
+		Order(goqu.I(COLUMN_CREATED_AT).Desc()).
+		ToSQL()
+
+	if errSql != nil {
+		return []SlugRedirectInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []SlugRedirectInterface{}, err
+	}
+
+	list := lo.Map(modelMaps, func(modelMap map[string]string, index int) SlugRedirectInterface {
+		return NewSlugRedirectFromExistingData(modelMap)
+	})
+
+	return list, nil
+}
+
+// slugUnique slugifies the slug and returns it, suffixed with the lowest
+// number (-2, -3, ...) not taken by another row of the table, soft deleted
+// rows included. Empty slugs fall back to the ID of the row.
+func (store *Store) slugUnique(ctx context.Context, tableName string, id string, slug string) (string, error) {
+	slug = strutils.Slugify(slug, '-')
+
+	if slug == "" {
+		slug = strings.ToLower(id)
+	}
+
+	candidate := slug
+
+	for suffix := 2; ; suffix++ {
+		sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+			From(tableName).
+			Prepared(true).
+			Select(COLUMN_ID).
+			Where(goqu.C(COLUMN_SLUG).Eq(candidate)).
+			Where(goqu.C(COLUMN_ID).Neq(id)).
+			Limit(1).
+			ToSQL()
+
+		if errSql != nil {
+			return "", errSql
+		}
+
+		store.logSql("select", sqlStr, params...)
+
+		mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(mapped) < 1 {
+			return candidate, nil
+		}
+
+		candidate = slug + "-" + strconv.Itoa(suffix)
+	}
+}
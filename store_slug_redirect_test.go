@@ -0,0 +1,204 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreProductSlug(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	first := NewProduct().SetTitle("Blue Shirt")
+	second := NewProduct().SetTitle("Blue Shirt")
+
+	for _, product := range []ProductInterface{first, second} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if first.Slug() != "blue-shirt" {
+		t.Fatal("Product slug MUST be blue-shirt, found:", first.Slug())
+	}
+
+	if second.Slug() != "blue-shirt-2" {
+		t.Fatal("Product slug MUST be blue-shirt-2, found:", second.Slug())
+	}
+
+	// Renaming keeps the slug
+	first.SetTitle("Navy Shirt")
+
+	if err := store.ProductUpdate(ctx, first); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindBySlug(ctx, "blue-shirt")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound == nil || productFound.ID() != first.ID() {
+		t.Fatal("Product with slug blue-shirt MUST be found, found:", productFound)
+	}
+
+	first.SetSlug("navy-shirt")
+
+	if err := store.ProductUpdate(ctx, first); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err = store.ProductFindBySlug(ctx, "blue-shirt")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound == nil || productFound.ID() != first.ID() {
+		t.Fatal("Product MUST be found by its previous slug, found:", productFound)
+	}
+
+	if productFound.Slug() != "navy-shirt" {
+		t.Fatal("Product slug MUST be navy-shirt, found:", productFound.Slug())
+	}
+
+	redirects, err := store.SlugRedirectList(ctx, SLUG_REDIRECT_ENTITY_TYPE_PRODUCT, first.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(redirects) != 1 || redirects[0].Slug() != "blue-shirt" {
+		t.Fatal("Product MUST have 1 slug redirect from blue-shirt, found:", redirects)
+	}
+
+	// A taken slug is de-duplicated on update too
+	second.SetSlug("navy-shirt")
+
+	if err := store.ProductUpdate(ctx, second); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if second.Slug() != "navy-shirt-2" {
+		t.Fatal("Product slug MUST be navy-shirt-2, found:", second.Slug())
+	}
+
+	productFound, err = store.ProductFindBySlug(ctx, "unknown-shirt")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound != nil {
+		t.Fatal("Product MUST be nil")
+	}
+}
+
+func TestStoreProductSlugUpdateFailed(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	ruler := NewProduct().SetTitle("Ruler").SetSKU("RUL-30")
+	pencil := NewProduct().SetTitle("Pencil").SetSKU("PEN-HB")
+
+	for _, product := range []ProductInterface{ruler, pencil} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	// The duplicate SKU fails the update after the slug was changed
+	pencil.SetSlug("ruler")
+	pencil.SetSKU("RUL-30")
+
+	if err := store.ProductUpdate(ctx, pencil); err == nil {
+		t.Fatal("Product with a duplicate SKU MUST NOT be updated")
+	}
+
+	if pencil.Slug() != "ruler" {
+		t.Fatal("Product slug MUST be the one set, ruler, found:", pencil.Slug())
+	}
+
+	if _, slugChanged := pencil.DataChanged()[COLUMN_SLUG]; !slugChanged {
+		t.Fatal("Product slug MUST still be changed")
+	}
+
+	redirects, err := store.SlugRedirectList(ctx, SLUG_REDIRECT_ENTITY_TYPE_PRODUCT, pencil.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(redirects) != 0 {
+		t.Fatal("Product MUST have no slug redirects, found:", len(redirects))
+	}
+
+	// Retried with a SKU of its own
+	pencil.SetSKU("PEN-2B")
+
+	if err := store.ProductUpdate(ctx, pencil); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if pencil.Slug() != "ruler-2" {
+		t.Fatal("Product slug MUST be ruler-2, found:", pencil.Slug())
+	}
+
+	if len(pencil.DataChanged()) != 0 {
+		t.Fatal("Product MUST NOT be dirty after the update, found:", pencil.DataChanged())
+	}
+}
+
+func TestStoreCategorySlug(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	first := NewCategory().SetTitle("Shoes")
+	second := NewCategory().SetTitle("Shoes")
+
+	for _, category := range []CategoryInterface{first, second} {
+		if err := store.CategoryCreate(ctx, category); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if second.Slug() != "shoes-2" {
+		t.Fatal("Category slug MUST be shoes-2, found:", second.Slug())
+	}
+
+	second.SetSlug("Trainers")
+
+	if err := store.CategoryUpdate(ctx, second); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	categoryFound, err := store.CategoryFindBySlug(ctx, "shoes-2")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if categoryFound == nil || categoryFound.ID() != second.ID() {
+		t.Fatal("Category MUST be found by its previous slug, found:", categoryFound)
+	}
+
+	if categoryFound.Slug() != "trainers" {
+		t.Fatal("Category slug MUST be trainers, found:", categoryFound.Slug())
+	}
+}
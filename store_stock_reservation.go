@@ -0,0 +1,562 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/base/database"
+	"github.com/gouniverse/sb"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+// AvailableQuantity returns the quantity of the product that can still be
+// sold, its stock less the quantities held by active reservations that
+// have not expired yet
+func (store *Store) AvailableQuantity(ctx context.Context, productID string) (int64, error) {
+	if productID == "" {
+		return 0, errors.New("product id is empty")
+	}
+
+	return store.productAvailableQuantity(ctx, productID, "")
+}
+
+// StockReserve holds the quantity of the product for the pending order
+// for the given duration (STOCK_RESERVATION_MINUTES if not positive).
+//
+// The reservation is taken from the stock once the order moves on to be
+// fulfilled, even if it expired meanwhile, and released if the order is
+// cancelled or declined. Expired reservations no longer hold the stock
+// (see AvailableQuantity). Orders placed with PlaceOrder have their
+// stock taken already and cannot reserve it again.
+func (store *Store) StockReserve(ctx context.Context, orderID string, productID string, quantity int64, duration time.Duration) (StockReservationInterface, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is empty")
+	}
+
+	if productID == "" {
+		return nil, errors.New("product id is empty")
+	}
+
+	if quantity < 1 {
+		return nil, errors.New("quantity must be greater than 0")
+	}
+
+	if duration <= 0 {
+		duration = STOCK_RESERVATION_MINUTES * time.Minute
+	}
+
+	reservation := NewStockReservation().
+		SetOrderID(orderID).
+		SetProductID(productID).
+		SetQuantityInt(quantity).
+		SetExpiresAt(carbon.Now(carbon.UTC).AddSeconds(int(duration.Seconds())).ToDateTimeString(carbon.UTC))
+
+	err := store.transaction(ctx, func(txCtx database.QueryableContext) error {
+		order, err := store.OrderFindByID(txCtx, orderID)
+
+		if err != nil {
+			return err
+		}
+
+		if order == nil {
+			return errors.New("order not found")
+		}
+
+		if !stockReservationHeldBy(order.Status()) {
+			return errors.New("order " + orderID + " is " + order.Status() + ", stock can only be reserved for pending orders")
+		}
+
+		consumed, err := store.StockReservationList(txCtx, NewStockReservationQuery().
+			SetOrderID(orderID).
+			SetStatus(STOCK_RESERVATION_STATUS_CONSUMED).
+			SetLimit(1))
+
+		if err != nil {
+			return err
+		}
+
+		if len(consumed) > 0 {
+			return errors.New("order " + orderID + " has its stock taken already")
+		}
+
+		// Reservations of the same product are made one after the other
+		if err := store.productLock(txCtx, productID); err != nil {
+			return err
+		}
+
+		product, err := store.ProductFindByID(txCtx, productID)
+
+		if err != nil {
+			return err
+		}
+
+		if product == nil {
+			return errors.New("product " + productID + " not found")
+		}
+
+		if !product.IsActive() {
+			return errors.New("product " + productID + " is not active")
+		}
+
+		available, err := store.productAvailableQuantity(txCtx, productID, "")
+
+		if err != nil {
+			return err
+		}
+
+		if available < quantity {
+			return errors.New("product " + productID + " has insufficient stock")
+		}
+
+		return store.stockReservationInsert(txCtx, reservation)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (store *Store) StockReservationFindByID(ctx context.Context, id string) (StockReservationInterface, error) {
+	if id == "" {
+		return nil, errors.New("stock reservation id is empty")
+	}
+
+	list, err := store.StockReservationList(ctx, NewStockReservationQuery().
+		SetID(id).
+		SetLimit(1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) > 0 {
+		return list[0], nil
+	}
+
+	return nil, nil
+}
+
+func (store *Store) StockReservationList(ctx context.Context, options StockReservationQueryInterface) ([]StockReservationInterface, error) {
+	q, columns, err := store.stockReservationQuery(options)
+
+	if err != nil {
+		return []StockReservationInterface{}, err
+	}
+
+	sqlStr, params, errSql := q.Prepared(true).Select(columns...).ToSQL()
+
+	if errSql != nil {
+		return []StockReservationInterface{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	modelMaps, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []StockReservationInterface{}, err
+	}
+
+	list := []StockReservationInterface{}
+
+	lo.ForEach(modelMaps, func(modelMap map[string]string, index int) {
+		model := NewStockReservationFromExistingData(modelMap)
+		list = append(list, model)
+	})
+
+	return list, nil
+}
+
+// StockReservationRelease releases the active reservation, so its
+// quantity can be sold again
+func (store *Store) StockReservationRelease(ctx context.Context, id string) error {
+	reservation, err := store.StockReservationFindByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if reservation == nil {
+		return errors.New("stock reservation not found")
+	}
+
+	if !reservation.IsActive() {
+		return errors.New("stock reservation " + id + " is " + reservation.Status())
+	}
+
+	return store.stockReservationStatusUpdate(ctx, reservation, STOCK_RESERVATION_STATUS_RELEASED)
+}
+
+// stockReservationHeldBy returns true if orders with the given status
+// keep their stock reservations, i.e. have not been paid yet
+func stockReservationHeldBy(orderStatus string) bool {
+	return lo.Contains([]string{
+		ORDER_STATUS_PENDING,
+		ORDER_STATUS_AWAITING_PAYMENT,
+		ORDER_STATUS_MANUAL_VERIFICATION_REQUIRED,
+	}, orderStatus)
+}
+
+// stockReservationsApplyOrderStatus settles the reservations of the order
+// after its status changed. Cancelled and declined orders release them
+// (see stockReservationsRelease), orders moving on to be fulfilled take
+// the active and expired reservations from the stock. Released
+// reservations were given back on purpose and are not taken again.
+func (store *Store) stockReservationsApplyOrderStatus(ctx context.Context, orderID string, orderStatus string) error {
+	if stockReservationHeldBy(orderStatus) {
+		return nil
+	}
+
+	if orderStatus == ORDER_STATUS_CANCELLED || orderStatus == ORDER_STATUS_DECLINED {
+		return store.stockReservationsRelease(ctx, orderID)
+	}
+
+	reservations, err := store.StockReservationList(ctx, NewStockReservationQuery().
+		SetOrderID(orderID))
+
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		if reservation.Status() != STOCK_RESERVATION_STATUS_ACTIVE &&
+			reservation.Status() != STOCK_RESERVATION_STATUS_EXPIRED {
+			continue
+		}
+
+		if err := store.stockReservationConsume(ctx, reservation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stockReservationConsume takes the reserved quantity from the stock of the
+// product, or of its variant, and marks the reservation consumed. This is
+// the only place stock is taken. The stock is checked again with the
+// product locked, as an expired reservation no longer held it.
+func (store *Store) stockReservationConsume(ctx context.Context, reservation StockReservationInterface) error {
+	if err := store.productLock(ctx, reservation.ProductID()); err != nil {
+		return err
+	}
+
+	// Variants have a stock of their own
+	if reservation.VariantID() != "" {
+		if err := store.productVariantStockDecrement(ctx, reservation.ProductID(), reservation.VariantID(), reservation.QuantityInt()); err != nil {
+			return err
+		}
+	} else {
+		if err := store.productStockDecrement(ctx, reservation.ProductID(), reservation.QuantityInt(), reservation.OrderID()); err != nil {
+			return err
+		}
+	}
+
+	return store.stockReservationStatusUpdate(ctx, reservation, STOCK_RESERVATION_STATUS_CONSUMED)
+}
+
+// stockReservationsRelease releases the reservations of the order. The
+// active ones no longer hold the stock, the consumed ones are put back into
+// the stock of the product, or of its variant, they were taken from.
+func (store *Store) stockReservationsRelease(ctx context.Context, orderID string) error {
+	reservations, err := store.StockReservationList(ctx, NewStockReservationQuery().
+		SetOrderID(orderID))
+
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		switch reservation.Status() {
+		case STOCK_RESERVATION_STATUS_ACTIVE:
+		case STOCK_RESERVATION_STATUS_CONSUMED:
+			if reservation.VariantID() != "" {
+				err = store.productVariantStockIncrement(ctx, reservation.VariantID(), reservation.QuantityInt())
+			} else {
+				err = store.productStockIncrement(ctx, reservation.ProductID(), reservation.QuantityInt())
+			}
+
+			if err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := store.stockReservationStatusUpdate(ctx, reservation, STOCK_RESERVATION_STATUS_RELEASED); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stockReservationsHolding selects the reservations holding the stock of
+// their products, the active ones not expired yet. Variants are not
+// reserved, their stock is only taken.
+func (store *Store) stockReservationsHolding() *goqu.SelectDataset {
+	return goqu.Dialect(store.dbDriverName).
+		From(store.stockReservationTableName).
+		Where(goqu.C(COLUMN_VARIANT_ID).Eq("")).
+		Where(goqu.C(COLUMN_STATUS).Eq(STOCK_RESERVATION_STATUS_ACTIVE)).
+		Where(goqu.C(COLUMN_EXPIRES_AT).Gt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)))
+}
+
+// stockReservationsExpire marks the active reservations expired before
+// the given date time as expired, and returns their IDs
+func (store *Store) stockReservationsExpire(ctx context.Context, expiresAtLte string) ([]string, error) {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		From(store.stockReservationTableName).
+		Prepared(true).
+		Select(COLUMN_ID).
+		Where(goqu.C(COLUMN_STATUS).Eq(STOCK_RESERVATION_STATUS_ACTIVE)).
+		Where(goqu.C(COLUMN_EXPIRES_AT).Lte(expiresAtLte)).
+		ToSQL()
+
+	if errSql != nil {
+		return []string{}, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return []string{}, err
+	}
+
+	ids := []string{}
+
+	for _, row := range mapped {
+		reservation := NewStockReservationFromExistingData(row)
+
+		if err := store.stockReservationStatusUpdate(ctx, reservation, STOCK_RESERVATION_STATUS_EXPIRED); err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, reservation.ID())
+	}
+
+	return ids, nil
+}
+
+// productAvailableQuantity returns the stock of the product less the
+// quantities held by active unexpired reservations, not counting the
+// reservations of the order with the given ID if not empty
+func (store *Store) productAvailableQuantity(ctx context.Context, productID string, exceptOrderID string) (int64, error) {
+	product, err := store.ProductFindByID(ctx, productID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if product == nil {
+		return 0, errors.New("product " + productID + " not found")
+	}
+
+	q := store.stockReservationsHolding().
+		Prepared(true).
+		Select(goqu.SUM(COLUMN_QUANTITY).As("reserved")).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(productID))
+
+	if exceptOrderID != "" {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Neq(exceptOrderID))
+	}
+
+	sqlStr, params, errSql := q.ToSQL()
+
+	if errSql != nil {
+		return 0, errSql
+	}
+
+	store.logSql("select", sqlStr, params...)
+
+	mapped, err := database.SelectToMapString(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return 0, err
+	}
+
+	reserved := int64(0)
+
+	if len(mapped) > 0 {
+		reserved = cast.ToInt64(mapped[0]["reserved"])
+	}
+
+	return product.QuantityInt() - reserved, nil
+}
+
+// productInStockFilter matches the products with a quantity available, the
+// same as productAvailableQuantity, or with an active variant in stock.
+// If inStock is false, it matches the products with neither.
+func (store *Store) productInStockFilter(inStock bool) exp.Expression {
+	reserved := store.stockReservationsHolding().
+		Select(goqu.COALESCE(goqu.SUM(COLUMN_QUANTITY), 0)).
+		Where(goqu.C(COLUMN_PRODUCT_ID).Eq(goqu.T(store.productTableName).Col(COLUMN_ID)))
+
+	variantsInStock := goqu.Dialect(store.dbDriverName).
+		From(store.productVariantTableName).
+		Select(COLUMN_PRODUCT_ID).
+		Where(goqu.C(COLUMN_STATUS).Eq(PRODUCT_VARIANT_STATUS_ACTIVE)).
+		Where(goqu.C(COLUMN_QUANTITY).Gt(0)).
+		Where(goqu.C(COLUMN_SOFT_DELETED_AT).Gt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)))
+
+	if inStock {
+		return goqu.Or(
+			goqu.L(`? > (?)`, goqu.C(COLUMN_QUANTITY), reserved),
+			goqu.C(COLUMN_ID).In(variantsInStock),
+		)
+	}
+
+	return goqu.And(
+		goqu.L(`? <= (?)`, goqu.C(COLUMN_QUANTITY), reserved),
+		goqu.C(COLUMN_ID).NotIn(variantsInStock),
+	)
+}
+
+// productLock touches the product, so concurrent transactions working
+// with its stock wait for each other
+func (store *Store) productLock(ctx context.Context, productID string) error {
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.productTableName).
+		Prepared(true).
+		Set(map[string]string{
+			COLUMN_UPDATED_AT: carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(productID)).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	return err
+}
+
+func (store *Store) stockReservationInsert(ctx context.Context, reservation StockReservationInterface) error {
+	reservation.SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+	reservation.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	data := reservation.Data()
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Insert(store.stockReservationTableName).
+		Prepared(true).
+		Rows(data).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("insert", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	reservation.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) stockReservationStatusUpdate(ctx context.Context, reservation StockReservationInterface, status string) error {
+	reservation.SetStatus(status)
+	reservation.SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	sqlStr, params, errSql := goqu.Dialect(store.dbDriverName).
+		Update(store.stockReservationTableName).
+		Prepared(true).
+		Set(map[string]string{
+			COLUMN_STATUS:     reservation.Status(),
+			COLUMN_UPDATED_AT: reservation.UpdatedAt(),
+		}).
+		Where(goqu.C(COLUMN_ID).Eq(reservation.ID())).
+		ToSQL()
+
+	if errSql != nil {
+		return errSql
+	}
+
+	store.logSql("update", sqlStr, params...)
+
+	_, err := database.Execute(store.toQuerableContext(ctx), sqlStr, params...)
+
+	if err != nil {
+		return err
+	}
+
+	reservation.MarkAsNotDirty()
+
+	return nil
+}
+
+func (store *Store) stockReservationQuery(options StockReservationQueryInterface) (selectDataset *goqu.SelectDataset, columns []any, err error) {
+	if options == nil {
+		return nil, nil, errors.New("stock reservation options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	q := goqu.Dialect(store.dbDriverName).From(store.stockReservationTableName)
+
+	if options.HasID() {
+		q = q.Where(goqu.C(COLUMN_ID).Eq(options.ID()))
+	}
+
+	if options.HasOrderID() {
+		q = q.Where(goqu.C(COLUMN_ORDER_ID).Eq(options.OrderID()))
+	}
+
+	if options.HasProductID() {
+		q = q.Where(goqu.C(COLUMN_PRODUCT_ID).Eq(options.ProductID()))
+	}
+
+	if options.HasStatus() {
+		q = q.Where(goqu.C(COLUMN_STATUS).Eq(options.Status()))
+	}
+
+	if !options.IsCountOnly() {
+		if options.HasLimit() {
+			q = q.Limit(cast.ToUint(options.Limit()))
+		}
+
+		if options.HasOffset() {
+			q = q.Offset(cast.ToUint(options.Offset()))
+		}
+	}
+
+	sortOrder := lo.Ternary(options.HasSortDirection(), options.SortDirection(), sb.DESC)
+
+	if options.HasOrderBy() {
+		if strings.EqualFold(sortOrder, sb.ASC) {
+			q = q.Order(goqu.I(options.OrderBy()).Asc())
+		} else {
+			q = q.Order(goqu.I(options.OrderBy()).Desc())
+		}
+	}
+
+	columns = []any{}
+
+	for _, column := range options.Columns() {
+		columns = append(columns, column)
+	}
+
+	return q, columns, nil
+}
@@ -0,0 +1,371 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStoreStockReserve(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Book").
+		SetQuantityInt(5)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	first := NewOrder().SetCustomerID("CUSTOMER01_ID")
+	second := NewOrder().SetCustomerID("CUSTOMER02_ID")
+
+	for _, order := range []OrderInterface{first, second} {
+		if err := store.OrderCreate(ctx, order); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if _, err := store.StockReserve(ctx, first.ID(), product.ID(), 3, 0); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	available, err := store.AvailableQuantity(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if available != 2 {
+		t.Fatal("Available quantity MUST be 2, found:", available)
+	}
+
+	if _, err := store.StockReserve(ctx, second.ID(), product.ID(), 3, 0); err == nil {
+		t.Fatal("Reserving more than the available quantity MUST fail")
+	}
+
+	// An expired reservation no longer holds the stock
+	if _, err := store.StockReserve(ctx, second.ID(), product.ID(), 2, time.Nanosecond); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	available, err = store.AvailableQuantity(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if available != 2 {
+		t.Fatal("Available quantity MUST be 2 after the reservation expired, found:", available)
+	}
+
+	// Paying the first order takes its reservation from the stock
+	if err := store.OrderTransition(ctx, first, ORDER_STATUS_AWAITING_FULFILLMENT); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 2 {
+		t.Fatal("Product quantity MUST be 2, found:", productFound.QuantityInt())
+	}
+
+	reservations, err := store.StockReservationList(ctx, NewStockReservationQuery().SetOrderID(first.ID()))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(reservations) != 1 || reservations[0].Status() != STOCK_RESERVATION_STATUS_CONSUMED {
+		t.Fatal("Stock reservation MUST be consumed, found:", reservations)
+	}
+}
+
+func TestStoreStockReservationReleasedOnCancel(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Book").
+		SetQuantityInt(2)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reservation, err := store.StockReserve(ctx, order.ID(), product.ID(), 2, 0)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The reserved stock cannot be sold to another order
+	other := NewOrder().SetCustomerID("CUSTOMER02_ID")
+
+	err = store.PlaceOrder(ctx, other, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetPriceFloat(10).SetQuantityInt(1),
+	})
+
+	if err == nil {
+		t.Fatal("Placing an order for reserved stock MUST fail")
+	}
+
+	if err := store.OrderTransition(ctx, order, ORDER_STATUS_CANCELLED); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reservationFound, err := store.StockReservationFindByID(ctx, reservation.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if reservationFound.Status() != STOCK_RESERVATION_STATUS_RELEASED {
+		t.Fatal("Stock reservation MUST be released, found:", reservationFound.Status())
+	}
+
+	available, err := store.AvailableQuantity(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if available != 2 {
+		t.Fatal("Available quantity MUST be 2, found:", available)
+	}
+}
+
+func TestStoreStockReservationConsumedAfterExpiry(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Book").
+		SetQuantityInt(5)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reservation, err := store.StockReserve(ctx, order.ID(), product.ID(), 2, time.Nanosecond)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.(*Store).stockReservationsExpire(ctx, reservation.ExpiresAt()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Paying the order still takes the expired reservation from the stock
+	if err := store.OrderTransition(ctx, order, ORDER_STATUS_AWAITING_FULFILLMENT); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 3 {
+		t.Fatal("Product quantity MUST be 3, found:", productFound.QuantityInt())
+	}
+
+	reservationFound, err := store.StockReservationFindByID(ctx, reservation.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if reservationFound.Status() != STOCK_RESERVATION_STATUS_CONSUMED {
+		t.Fatal("Stock reservation MUST be consumed, found:", reservationFound.Status())
+	}
+
+	// Placed orders have their stock taken already
+	placed := NewOrder().SetCustomerID("CUSTOMER02_ID")
+
+	err = store.PlaceOrder(ctx, placed, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(product.ID()).SetPriceFloat(10).SetQuantityInt(1),
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.StockReserve(ctx, placed.ID(), product.ID(), 1, 0); err == nil {
+		t.Fatal("Reserving stock for a placed order MUST fail")
+	}
+
+	if err := store.OrderTransition(ctx, placed, ORDER_STATUS_AWAITING_FULFILLMENT); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err = store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 2 {
+		t.Fatal("Product quantity MUST be 2, taken once for the placed order, found:", productFound.QuantityInt())
+	}
+}
+
+func TestStoreStockReservationReleasedNotConsumed(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetStatus(PRODUCT_STATUS_ACTIVE).
+		SetTitle("Book").
+		SetQuantityInt(5)
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reservation, err := store.StockReserve(ctx, order.ID(), product.ID(), 3, time.Hour)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.StockReservationRelease(ctx, reservation.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Paying the order does not take the released stock again
+	if err := store.OrderTransition(ctx, order, ORDER_STATUS_AWAITING_FULFILLMENT); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if productFound.QuantityInt() != 5 {
+		t.Fatal("Product quantity MUST be 5, found:", productFound.QuantityInt())
+	}
+
+	reservationFound, err := store.StockReservationFindByID(ctx, reservation.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if reservationFound.Status() != STOCK_RESERVATION_STATUS_RELEASED {
+		t.Fatal("Stock reservation MUST be released, found:", reservationFound.Status())
+	}
+}
+
+func TestStoreProductListInStock(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	reserved := NewProduct().SetStatus(PRODUCT_STATUS_ACTIVE).SetTitle("Book").SetQuantityInt(2)
+	available := NewProduct().SetStatus(PRODUCT_STATUS_ACTIVE).SetTitle("Pen").SetQuantityInt(1)
+	variants := NewProduct().SetStatus(PRODUCT_STATUS_ACTIVE).SetTitle("T-shirt").SetQuantityInt(0)
+
+	for _, product := range []ProductInterface{reserved, available, variants} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	variant := NewProductVariant().
+		SetProductID(variants.ID()).
+		SetSKU("TS-L").
+		SetTitle("T-shirt L").
+		SetQuantityInt(3)
+
+	if err := store.ProductVariantCreate(ctx, variant); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := store.OrderCreate(ctx, order); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := store.StockReserve(ctx, order.ID(), reserved.ID(), 2, 0); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	list, err := store.ProductList(ctx, NewProductQuery().
+		SetInStock(true).
+		SetOrderBy(COLUMN_TITLE).
+		SetSortDirection("asc"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 2 || list[0].ID() != available.ID() || list[1].ID() != variants.ID() {
+		t.Fatal("Products in stock MUST be the pen and the T-shirt with a variant in stock, found:", len(list))
+	}
+
+	list, err = store.ProductList(ctx, NewProductQuery().SetInStock(false))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 || list[0].ID() != reserved.ID() {
+		t.Fatal("Products out of stock MUST be the fully reserved book, found:", len(list))
+	}
+}
@@ -0,0 +1,152 @@
+package shopstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+// TaxCalculateLineItems calculates the tax of each line item for a customer
+// in the given region, returned in the order of the line items.
+//
+// The rate for a line item is the active rate of the region for the category
+// of its product closest in the category tree (the product categories first,
+// then their parents and so on). If no category rate matches, the rate of the
+// region for all categories applies. If several rates match equally the
+// highest rate is used. Line items without a matching rate have no tax.
+func (store *Store) TaxCalculateLineItems(ctx context.Context, region string, lineItems []OrderLineItemInterface) ([]LineItemTax, error) {
+	return store.taxCalculateLineItems(ctx, region, lineItems, Money{})
+}
+
+// taxCalculateLineItems calculates the tax of each line item as
+// TaxCalculateLineItems does, less the discount of the order spread
+// across the line items in proportion to their totals, so the tax is
+// on what is paid for each line item
+func (store *Store) taxCalculateLineItems(ctx context.Context, region string, lineItems []OrderLineItemInterface, discount Money) ([]LineItemTax, error) {
+	taxes := lo.Map(lineItems, func(lineItem OrderLineItemInterface, index int) LineItemTax {
+		return LineItemTax{
+			LineItem: lineItem,
+			Tax:      NewMoney(0, lineItem.Currency()),
+		}
+	})
+
+	if region == "" || len(lineItems) < 1 {
+		return taxes, nil
+	}
+
+	rates, err := store.TaxRateList(ctx, NewTaxRateQuery().
+		SetStatus(TAX_RATE_STATUS_ACTIVE).
+		SetRegion(NewTaxRate().SetRegion(region).Region()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rates) < 1 {
+		return taxes, nil
+	}
+
+	totals := lo.Map(lineItems, func(lineItem OrderLineItemInterface, index int) Money {
+		return lineItem.PriceMoney().Mul(lineItem.QuantityInt())
+	})
+
+	// Only a discount is spread, in the currency of the line items
+	discounts := lo.Map(totals, func(total Money, index int) Money {
+		return NewMoney(0, total.Currency())
+	})
+
+	if !discount.IsZero() {
+		for _, total := range totals {
+			if !total.SameCurrency(discount) {
+				return nil, errors.New("order line item currency " + total.Currency() + " does not match the discount currency " + discount.Currency())
+			}
+		}
+
+		discounts = moneySpread(discount, totals)
+	}
+
+	productRates := map[string]TaxRateInterface{}
+
+	for i, lineItem := range lineItems {
+		rate, cached := productRates[lineItem.ProductID()]
+
+		if !cached {
+			rate, err = store.taxRateForProduct(ctx, rates, lineItem.ProductID())
+
+			if err != nil {
+				return nil, err
+			}
+
+			productRates[lineItem.ProductID()] = rate
+		}
+
+		if rate == nil {
+			continue
+		}
+
+		taxes[i].TaxRate = rate
+		taxes[i].Tax = rate.Calculate(totals[i].Sub(discounts[i]))
+	}
+
+	return taxes, nil
+}
+
+// taxRateForProduct selects from the region rates the one for the product,
+// or nil if none applies
+func (store *Store) taxRateForProduct(ctx context.Context, rates []TaxRateInterface, productID string) (TaxRateInterface, error) {
+	ratesByCategory := lo.GroupBy(rates, func(rate TaxRateInterface) string {
+		return rate.CategoryID()
+	})
+
+	highest := func(rates []TaxRateInterface) TaxRateInterface {
+		return lo.MaxBy(rates, func(a TaxRateInterface, b TaxRateInterface) bool {
+			return a.RateFloat() > b.RateFloat()
+		})
+	}
+
+	if productID != "" && len(ratesByCategory) > len(ratesByCategory[""]) {
+		categoryIDs, err := store.ProductCategoryList(ctx, productID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Category IDs grouped by their distance from the product,
+		// level 0 being the categories the product is assigned to
+		levels := [][]string{categoryIDs}
+
+		for _, categoryID := range categoryIDs {
+			ancestors, err := store.CategoryAncestors(ctx, categoryID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Ancestors are root first, the closest parent is the last
+			for distance, ancestor := range lo.Reverse(ancestors) {
+				for len(levels) <= distance+1 {
+					levels = append(levels, []string{})
+				}
+
+				levels[distance+1] = append(levels[distance+1], ancestor.ID())
+			}
+		}
+
+		for _, level := range levels {
+			matching := lo.FlatMap(lo.Uniq(level), func(categoryID string, index int) []TaxRateInterface {
+				return ratesByCategory[categoryID]
+			})
+
+			if len(matching) > 0 {
+				return highest(matching), nil
+			}
+		}
+	}
+
+	if len(ratesByCategory[""]) > 0 {
+		return highest(ratesByCategory[""]), nil
+	}
+
+	return nil, nil
+}
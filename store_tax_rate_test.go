@@ -0,0 +1,203 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStoreTaxRateCreateAndFind(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	taxRate := NewTaxRate().
+		SetStatus(TAX_RATE_STATUS_ACTIVE).
+		SetTitle("UK VAT").
+		SetRegion("gb").
+		SetRateFloat(20).
+		SetInclusive(true)
+
+	if err := store.TaxRateCreate(ctx, taxRate); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	taxRateFound, err := store.TaxRateFindByID(ctx, taxRate.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if taxRateFound == nil {
+		t.Fatal("Tax rate MUST NOT be nil")
+	}
+
+	if taxRateFound.Region() != "GB" {
+		t.Fatal("Tax rate region MUST be GB, found:", taxRateFound.Region())
+	}
+
+	if taxRateFound.RateFloat() != 20 {
+		t.Fatal("Tax rate MUST be 20, found:", taxRateFound.Rate())
+	}
+
+	if !taxRateFound.IsInclusive() {
+		t.Fatal("Tax rate MUST be inclusive")
+	}
+
+	taxRateFound.SetInclusive(false)
+
+	if err := store.TaxRateUpdate(ctx, taxRateFound); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	list, err := store.TaxRateList(ctx, NewTaxRateQuery().SetRegion("GB"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatal("Tax rate list MUST have 1 item, found:", len(list))
+	}
+
+	if list[0].IsInclusive() {
+		t.Fatal("Tax rate MUST NOT be inclusive after update")
+	}
+
+	if err := store.TaxRateSoftDeleteByID(ctx, taxRate.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	count, err := store.TaxRateCount(ctx, NewTaxRateQuery().SetRegion("GB"))
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if count != 0 {
+		t.Fatal("Tax rate count MUST be 0 after soft delete, found:", count)
+	}
+}
+
+func TestStoreTaxCalculateLineItems(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	food := NewCategory().SetStatus(CATEGORY_STATUS_ACTIVE).SetTitle("Food")
+	fruit := NewCategory().SetStatus(CATEGORY_STATUS_ACTIVE).SetTitle("Fruit").SetParentID(food.ID())
+
+	for _, category := range []CategoryInterface{food, fruit} {
+		if err := store.CategoryCreate(ctx, category); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	apple := NewProduct().SetStatus(PRODUCT_STATUS_ACTIVE).SetTitle("Apple")
+	book := NewProduct().SetStatus(PRODUCT_STATUS_ACTIVE).SetTitle("Book")
+
+	for _, product := range []ProductInterface{apple, book} {
+		if err := store.ProductCreate(ctx, product); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if err := store.ProductCategoryAdd(ctx, apple.ID(), fruit.ID()); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	rates := []TaxRateInterface{
+		NewTaxRate().SetStatus(TAX_RATE_STATUS_ACTIVE).SetRegion("US-NY").SetRateFloat(8.875),
+		NewTaxRate().SetStatus(TAX_RATE_STATUS_ACTIVE).SetRegion("US-NY").SetRateFloat(0).SetCategoryID(food.ID()),
+		NewTaxRate().SetStatus(TAX_RATE_STATUS_ACTIVE).SetRegion("GB").SetRateFloat(20).SetInclusive(true),
+		NewTaxRate().SetStatus(TAX_RATE_STATUS_INACTIVE).SetRegion("FR").SetRateFloat(20),
+	}
+
+	for _, rate := range rates {
+		if err := store.TaxRateCreate(ctx, rate); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	lineItems := []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(apple.ID()).SetPriceFloat(2).SetQuantityInt(3),
+		NewOrderLineItem().SetProductID(book.ID()).SetPriceFloat(10).SetQuantityInt(1),
+	}
+
+	taxes, err := store.TaxCalculateLineItems(ctx, "US-NY", lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// The apple is fruit, fruit is food, food is not taxed
+	if !taxes[0].Tax.IsZero() {
+		t.Fatal("Apple tax MUST be 0, found:", taxes[0].Tax.String())
+	}
+
+	// 8.875% of 10.00 is 0.8875
+	if taxes[1].Tax.Amount() != 89 {
+		t.Fatal("Book tax MUST be 0.89, found:", taxes[1].Tax.String())
+	}
+
+	taxes, err = store.TaxCalculateLineItems(ctx, "GB", lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// 20% included in 10.00 is 1.6666
+	if taxes[1].Tax.Amount() != 167 || !taxes[1].IsInclusive() {
+		t.Fatal("Book tax MUST be 1.67 inclusive, found:", taxes[1].Tax.String(), taxes[1].IsInclusive())
+	}
+
+	taxes, err = store.TaxCalculateLineItems(ctx, "FR", lineItems)
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if taxes[1].TaxRate != nil || !taxes[1].Tax.IsZero() {
+		t.Fatal("Book tax MUST be 0, the rate is inactive, found:", taxes[1].Tax.String())
+	}
+
+	order := NewOrder().SetCustomerID("CUSTOMER01_ID")
+
+	if err := order.UpsertMetas(map[string]string{ORDER_META_TAX_REGION: "US-NY"}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.PlaceOrder(ctx, order, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(book.ID()).SetPriceFloat(10).SetQuantityInt(2),
+	}); err == nil {
+		t.Fatal("expected error, the book has no stock")
+	}
+
+	book.SetQuantityInt(5)
+
+	if err := store.ProductUpdate(ctx, book); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := store.PlaceOrder(ctx, order, []OrderLineItemInterface{
+		NewOrderLineItem().SetProductID(book.ID()).SetPriceFloat(10).SetQuantityInt(2),
+	}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// 8.875% of 20.00 is 1.775
+	if order.TaxTotalMoney().Amount() != 178 {
+		t.Fatal("Order tax MUST be 1.78, found:", order.TaxTotal())
+	}
+
+	if order.GrandTotalMoney().Amount() != 2178 {
+		t.Fatal("Order grand total MUST be 21.78, found:", order.GrandTotal())
+	}
+}
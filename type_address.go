@@ -0,0 +1,302 @@
+package shopstore
+
+import (
+	"strings"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CONSTANTS ================================================================
+
+const ADDRESS_TYPE_BILLING = "billing"
+const ADDRESS_TYPE_SHIPPING = "shipping"
+
+// == CLASS ====================================================================
+
+// Address is an entry in the address book of a customer
+type Address struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ AddressInterface = (*Address)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewAddress() AddressInterface {
+	o := (&Address{}).
+		SetID(uid.HumanUid()).
+		SetCustomerID("").
+		SetType(ADDRESS_TYPE_SHIPPING).
+		SetFirstName("").
+		SetLastName("").
+		SetCompany("").
+		SetAddressLine1("").
+		SetAddressLine2("").
+		SetCity("").
+		SetRegion("").
+		SetPostcode("").
+		SetCountry("").
+		SetPhone("").
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewAddressFromExistingData(data map[string]string) AddressInterface {
+	o := &Address{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+func (o *Address) IsBilling() bool {
+	return o.Type() == ADDRESS_TYPE_BILLING
+}
+
+func (o *Address) IsShipping() bool {
+	return o.Type() == ADDRESS_TYPE_SHIPPING
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *Address) AddressLine1() string {
+	return o.Get(COLUMN_ADDRESS_LINE_1)
+}
+
+func (o *Address) SetAddressLine1(addressLine1 string) AddressInterface {
+	o.Set(COLUMN_ADDRESS_LINE_1, addressLine1)
+	return o
+}
+
+func (o *Address) AddressLine2() string {
+	return o.Get(COLUMN_ADDRESS_LINE_2)
+}
+
+func (o *Address) SetAddressLine2(addressLine2 string) AddressInterface {
+	o.Set(COLUMN_ADDRESS_LINE_2, addressLine2)
+	return o
+}
+
+func (o *Address) City() string {
+	return o.Get(COLUMN_CITY)
+}
+
+func (o *Address) SetCity(city string) AddressInterface {
+	o.Set(COLUMN_CITY, city)
+	return o
+}
+
+func (o *Address) Company() string {
+	return o.Get(COLUMN_COMPANY)
+}
+
+func (o *Address) SetCompany(company string) AddressInterface {
+	o.Set(COLUMN_COMPANY, company)
+	return o
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country
+func (o *Address) Country() string {
+	return o.Get(COLUMN_COUNTRY)
+}
+
+func (o *Address) SetCountry(country string) AddressInterface {
+	o.Set(COLUMN_COUNTRY, strings.ToUpper(country))
+	return o
+}
+
+func (o *Address) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Address) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Address) SetCreatedAt(createdAt string) AddressInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Address) CustomerID() string {
+	return o.Get(COLUMN_CUSTOMER_ID)
+}
+
+func (o *Address) SetCustomerID(customerID string) AddressInterface {
+	o.Set(COLUMN_CUSTOMER_ID, customerID)
+	return o
+}
+
+func (o *Address) FirstName() string {
+	return o.Get(COLUMN_FIRST_NAME)
+}
+
+func (o *Address) SetFirstName(firstName string) AddressInterface {
+	o.Set(COLUMN_FIRST_NAME, firstName)
+	return o
+}
+
+func (o *Address) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Address) SetID(id string) AddressInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Address) LastName() string {
+	return o.Get(COLUMN_LAST_NAME)
+}
+
+func (o *Address) SetLastName(lastName string) AddressInterface {
+	o.Set(COLUMN_LAST_NAME, lastName)
+	return o
+}
+
+func (o *Address) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Address) SetMemo(memo string) AddressInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Address) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *Address) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *Address) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *Address) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *Address) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+func (o *Address) Phone() string {
+	return o.Get(COLUMN_PHONE)
+}
+
+func (o *Address) SetPhone(phone string) AddressInterface {
+	o.Set(COLUMN_PHONE, phone)
+	return o
+}
+
+func (o *Address) Postcode() string {
+	return o.Get(COLUMN_POSTCODE)
+}
+
+func (o *Address) SetPostcode(postcode string) AddressInterface {
+	o.Set(COLUMN_POSTCODE, postcode)
+	return o
+}
+
+// Region returns the state, province or county of the address
+func (o *Address) Region() string {
+	return o.Get(COLUMN_REGION)
+}
+
+func (o *Address) SetRegion(region string) AddressInterface {
+	o.Set(COLUMN_REGION, region)
+	return o
+}
+
+func (o *Address) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *Address) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *Address) SetSoftDeletedAt(softDeletedAt string) AddressInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+// Type returns one of the ADDRESS_TYPE_* constants
+func (o *Address) Type() string {
+	return o.Get(COLUMN_TYPE)
+}
+
+func (o *Address) SetType(type_ string) AddressInterface {
+	o.Set(COLUMN_TYPE, type_)
+	return o
+}
+
+func (o *Address) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *Address) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *Address) SetUpdatedAt(updatedAt string) AddressInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
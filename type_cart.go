@@ -0,0 +1,253 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CONSTANTS ================================================================
+
+const CART_STATUS_ACTIVE = "active"
+const CART_STATUS_CONVERTED = "converted"
+const CART_STATUS_ABANDONED = "abandoned"
+
+// CART_LIFETIME_DAYS is the number of days a new cart expires in
+const CART_LIFETIME_DAYS = 30
+
+// == CLASS ====================================================================
+
+// Cart is a shopping basket of a visitor (by session) or of a customer,
+// kept until it is converted to an order or it expires
+type Cart struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ CartInterface = (*Cart)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewCart() CartInterface {
+	o := (&Cart{}).
+		SetID(uid.HumanUid()).
+		SetStatus(CART_STATUS_ACTIVE).
+		SetSessionID("").
+		SetCustomerID("").
+		SetCurrency(MONEY_CURRENCY_DEFAULT).
+		SetOrderID("").
+		SetExpiresAt(carbon.Now(carbon.UTC).AddDays(CART_LIFETIME_DAYS).ToDateTimeString(carbon.UTC)).
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewCartFromExistingData(data map[string]string) CartInterface {
+	o := &Cart{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+func (o *Cart) IsActive() bool {
+	return o.Status() == CART_STATUS_ACTIVE
+}
+
+func (o *Cart) IsConverted() bool {
+	return o.Status() == CART_STATUS_CONVERTED
+}
+
+// IsExpired returns true if the expiry time of the cart has passed
+func (o *Cart) IsExpired() bool {
+	return o.ExpiresAtCarbon().Lte(carbon.Now(carbon.UTC))
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *Cart) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Cart) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Cart) SetCreatedAt(createdAt string) CartInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Cart) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *Cart) SetCurrency(currency string) CartInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *Cart) CustomerID() string {
+	return o.Get(COLUMN_CUSTOMER_ID)
+}
+
+func (o *Cart) SetCustomerID(customerID string) CartInterface {
+	o.Set(COLUMN_CUSTOMER_ID, customerID)
+	return o
+}
+
+func (o *Cart) ExpiresAt() string {
+	return o.Get(COLUMN_EXPIRES_AT)
+}
+
+func (o *Cart) ExpiresAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.ExpiresAt(), carbon.UTC)
+}
+
+func (o *Cart) SetExpiresAt(expiresAt string) CartInterface {
+	o.Set(COLUMN_EXPIRES_AT, expiresAt)
+	return o
+}
+
+func (o *Cart) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Cart) SetID(id string) CartInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Cart) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Cart) SetMemo(memo string) CartInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Cart) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *Cart) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *Cart) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *Cart) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *Cart) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+// OrderID returns the ID of the order the cart was converted to
+func (o *Cart) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *Cart) SetOrderID(orderID string) CartInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+// SessionID returns the ID of the session of the visitor owning the cart
+func (o *Cart) SessionID() string {
+	return o.Get(COLUMN_SESSION_ID)
+}
+
+func (o *Cart) SetSessionID(sessionID string) CartInterface {
+	o.Set(COLUMN_SESSION_ID, sessionID)
+	return o
+}
+
+func (o *Cart) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *Cart) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *Cart) SetSoftDeletedAt(softDeletedAt string) CartInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+func (o *Cart) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *Cart) SetStatus(status string) CartInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *Cart) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *Cart) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *Cart) SetUpdatedAt(updatedAt string) CartInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -0,0 +1,241 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CLASS ====================================================================
+
+// CartItem is a product put in a cart. The price of the product
+// is copied to the item, when the product is put in the cart.
+type CartItem struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ CartItemInterface = (*CartItem)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewCartItem() CartItemInterface {
+	o := (&CartItem{}).
+		SetID(uid.HumanUid()).
+		SetCartID("").
+		SetProductID("").
+		SetTitle("").
+		SetQuantityInt(1). // By default 1
+		SetPriceMoney(NewMoney(0, MONEY_CURRENCY_DEFAULT)).
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewCartItemFromExistingData(data map[string]string) CartItemInterface {
+	o := &CartItem{}
+	o.Hydrate(data)
+	return o
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *CartItem) CartID() string {
+	return o.Get(COLUMN_CART_ID)
+}
+
+func (o *CartItem) SetCartID(cartID string) CartItemInterface {
+	o.Set(COLUMN_CART_ID, cartID)
+	return o
+}
+
+func (o *CartItem) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *CartItem) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *CartItem) SetCreatedAt(createdAt string) CartItemInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *CartItem) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *CartItem) SetCurrency(currency string) CartItemInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *CartItem) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *CartItem) SetID(id string) CartItemInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *CartItem) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *CartItem) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *CartItem) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *CartItem) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *CartItem) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+func (o *CartItem) Price() string {
+	return o.Get(COLUMN_PRICE)
+}
+
+func (o *CartItem) SetPrice(price string) CartItemInterface {
+	o.Set(COLUMN_PRICE, price)
+	return o
+}
+
+// PriceMoney returns the price as exact money in the currency of the cart item
+func (o *CartItem) PriceMoney() Money {
+	price, err := NewMoneyFromString(o.Price(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the cart item
+func (o *CartItem) SetPriceMoney(price Money) CartItemInterface {
+	o.SetPrice(price.String())
+	o.SetCurrency(price.Currency())
+	return o
+}
+
+func (o *CartItem) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *CartItem) SetProductID(productID string) CartItemInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *CartItem) Quantity() string {
+	return o.Get(COLUMN_QUANTITY)
+}
+
+func (o *CartItem) SetQuantity(quantity string) CartItemInterface {
+	o.Set(COLUMN_QUANTITY, quantity)
+	return o
+}
+
+func (o *CartItem) QuantityInt() int64 {
+	quantity := o.Quantity()
+	quantityInt, _ := utils.ToInt(quantity)
+	return quantityInt
+}
+
+func (o *CartItem) SetQuantityInt(quantity int64) CartItemInterface {
+	o.SetQuantity(utils.ToString(quantity))
+	return o
+}
+
+func (o *CartItem) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *CartItem) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *CartItem) SetSoftDeletedAt(softDeletedAt string) CartItemInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+func (o *CartItem) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *CartItem) SetTitle(title string) CartItemInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+func (o *CartItem) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *CartItem) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *CartItem) SetUpdatedAt(updatedAt string) CartItemInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gouniverse/dataobject"
 	"github.com/gouniverse/maputils"
 	"github.com/gouniverse/sb"
+	"github.com/gouniverse/strutils"
 	"github.com/gouniverse/uid"
 	"github.com/gouniverse/utils"
 )
@@ -25,6 +26,7 @@ func NewCategory() CategoryInterface {
 		SetStatus(CATEGORY_STATUS_DRAFT).
 		SetParentID("").    // By default empty, root category
 		SetDescription(""). // By default empty
+		SetSlug("").        // By default from the title, see CategoryCreate
 		SetMemo("").        // By default empty
 		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
 		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
@@ -177,6 +179,23 @@ func (category *Category) SetParentID(parentID string) CategoryInterface {
 	return category
 }
 
+// Slug returns the slug of the category, or its slugified title
+// if the category has no slug set yet
+func (category *Category) Slug() string {
+	slug := category.Get(COLUMN_SLUG)
+
+	if slug != "" {
+		return slug
+	}
+
+	return strutils.Slugify(category.Title(), '-')
+}
+
+func (category *Category) SetSlug(slug string) CategoryInterface {
+	category.Set(COLUMN_SLUG, slug)
+	return category
+}
+
 func (category *Category) SoftDeletedAt() string {
 	return category.Get(COLUMN_SOFT_DELETED_AT)
 }
@@ -0,0 +1,17 @@
+package shopstore
+
+// == CLASS ====================================================================
+
+// CategoryTreeNode is a category together with its child categories,
+// as returned by Store.CategoryTree
+type CategoryTreeNode struct {
+	Category CategoryInterface
+	Children []CategoryTreeNode
+}
+
+// == METHODS ==================================================================
+
+// HasChildren returns true if the node has child categories
+func (node CategoryTreeNode) HasChildren() bool {
+	return len(node.Children) > 0
+}
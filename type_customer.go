@@ -0,0 +1,229 @@
+package shopstore
+
+import (
+	"strings"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CONSTANTS ================================================================
+
+const CUSTOMER_STATUS_ACTIVE = "active"
+const CUSTOMER_STATUS_INACTIVE = "inactive"
+
+// == CLASS ====================================================================
+
+type Customer struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ CustomerInterface = (*Customer)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewCustomer() CustomerInterface {
+	o := (&Customer{}).
+		SetID(uid.HumanUid()).
+		SetStatus(CUSTOMER_STATUS_ACTIVE).
+		SetEmail("").
+		SetFirstName("").
+		SetLastName("").
+		SetPhone("").
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewCustomerFromExistingData(data map[string]string) CustomerInterface {
+	o := &Customer{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+func (o *Customer) IsActive() bool {
+	return o.Status() == CUSTOMER_STATUS_ACTIVE
+}
+
+// Name returns the first and the last name of the customer
+func (o *Customer) Name() string {
+	return strings.TrimSpace(o.FirstName() + " " + o.LastName())
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *Customer) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Customer) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Customer) SetCreatedAt(createdAt string) CustomerInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Customer) Email() string {
+	return o.Get(COLUMN_EMAIL)
+}
+
+func (o *Customer) SetEmail(email string) CustomerInterface {
+	o.Set(COLUMN_EMAIL, email)
+	return o
+}
+
+func (o *Customer) FirstName() string {
+	return o.Get(COLUMN_FIRST_NAME)
+}
+
+func (o *Customer) SetFirstName(firstName string) CustomerInterface {
+	o.Set(COLUMN_FIRST_NAME, firstName)
+	return o
+}
+
+func (o *Customer) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Customer) SetID(id string) CustomerInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Customer) LastName() string {
+	return o.Get(COLUMN_LAST_NAME)
+}
+
+func (o *Customer) SetLastName(lastName string) CustomerInterface {
+	o.Set(COLUMN_LAST_NAME, lastName)
+	return o
+}
+
+func (o *Customer) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Customer) SetMemo(memo string) CustomerInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Customer) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *Customer) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *Customer) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *Customer) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *Customer) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+func (o *Customer) Phone() string {
+	return o.Get(COLUMN_PHONE)
+}
+
+func (o *Customer) SetPhone(phone string) CustomerInterface {
+	o.Set(COLUMN_PHONE, phone)
+	return o
+}
+
+func (o *Customer) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *Customer) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *Customer) SetSoftDeletedAt(softDeletedAt string) CustomerInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+func (o *Customer) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *Customer) SetStatus(status string) CustomerInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *Customer) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *Customer) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *Customer) SetUpdatedAt(updatedAt string) CustomerInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gouniverse/sb"
 	"github.com/gouniverse/uid"
 	"github.com/gouniverse/utils"
+	"github.com/spf13/cast"
 )
 
 // == CONSTANTS ==============================================================
@@ -45,6 +46,11 @@ func NewDiscount() DiscountInterface {
 		SetDescription("").
 		SetAmount(0.00).
 		SetCode(code).
+		SetCurrency(MONEY_CURRENCY_DEFAULT).
+		SetDuration(DISCOUNT_DURATION_FOREVER).
+		SetDurationInMonths(0).
+		SetMaxUses(0).            // By default unlimited
+		SetMaxUsesPerCustomer(0). // By default unlimited
 		SetStartsAt(sb.NULL_DATETIME).
 		SetEndsAt(sb.NULL_DATETIME).
 		SetMemo("").
@@ -65,6 +71,28 @@ func NewDiscountFromExistingData(data map[string]string) DiscountInterface {
 
 // == METHODS ================================================================
 
+// AppliesToBillingCycle returns whether the discount still applies to the
+// Nth (starting from 1) monthly billing cycle of a customer:
+//   - once - only the first billing cycle
+//   - months - the first DurationInMonths billing cycles
+//   - forever - every billing cycle
+func (d *Discount) AppliesToBillingCycle(cycle int) bool {
+	if cycle < 1 {
+		return false
+	}
+
+	switch d.Duration() {
+	case DISCOUNT_DURATION_ONCE:
+		return cycle == 1
+	case DISCOUNT_DURATION_MONTHS:
+		return cycle <= d.DurationInMonths()
+	case DISCOUNT_DURATION_FOREVER, "":
+		return true
+	}
+
+	return false
+}
+
 // == SETTERS AND GETTERS ====================================================
 
 func (d *Discount) Amount() float64 {
@@ -84,6 +112,25 @@ func (d *Discount) SetAmount(amount float64) DiscountInterface {
 	return d
 }
 
+// AmountMoney returns the amount as exact money in the currency of the discount.
+// For percent discounts the amount is the percent, i.e. 12.50 is 12.50%
+func (d *Discount) AmountMoney() Money {
+	amount, err := NewMoneyFromString(d.Get(COLUMN_AMOUNT), d.Currency())
+
+	if err != nil {
+		return NewMoney(0, d.Currency())
+	}
+
+	return amount
+}
+
+// SetAmountMoney sets the amount and the currency of the discount
+func (d *Discount) SetAmountMoney(amount Money) DiscountInterface {
+	d.Set(COLUMN_AMOUNT, amount.String())
+	d.SetCurrency(amount.Currency())
+	return d
+}
+
 func (d *Discount) Code() string {
 	return d.Get(COLUMN_CODE)
 }
@@ -107,6 +154,16 @@ func (d *Discount) SetCreatedAt(createdAt string) DiscountInterface {
 	return d
 }
 
+// Currency returns the ISO 4217 currency code of the discount amount
+func (d *Discount) Currency() string {
+	return d.Get(COLUMN_CURRENCY)
+}
+
+func (d *Discount) SetCurrency(currency string) DiscountInterface {
+	d.Set(COLUMN_CURRENCY, currency)
+	return d
+}
+
 func (d *Discount) Description() string {
 	return d.Get(COLUMN_DESCRIPTION)
 }
@@ -116,6 +173,28 @@ func (d *Discount) SetDescription(description string) DiscountInterface {
 	return d
 }
 
+// Duration returns how long the discount applies for a subscription,
+// one of the DISCOUNT_DURATION_* constants
+func (d *Discount) Duration() string {
+	return d.Get(COLUMN_DURATION)
+}
+
+func (d *Discount) SetDuration(duration string) DiscountInterface {
+	d.Set(COLUMN_DURATION, duration)
+	return d
+}
+
+// DurationInMonths returns the number of months the discount applies,
+// used only when the duration is DISCOUNT_DURATION_MONTHS
+func (d *Discount) DurationInMonths() int {
+	return cast.ToInt(d.Get(COLUMN_DURATION_IN_MONTHS))
+}
+
+func (d *Discount) SetDurationInMonths(durationInMonths int) DiscountInterface {
+	d.Set(COLUMN_DURATION_IN_MONTHS, cast.ToString(durationInMonths))
+	return d
+}
+
 func (d *Discount) EndsAt() string {
 	return d.Get(COLUMN_ENDS_AT)
 }
@@ -141,6 +220,26 @@ func (o *Discount) SetID(id string) DiscountInterface {
 	return o
 }
 
+// MaxUses returns how many times the discount may be redeemed in total, 0 is unlimited
+func (d *Discount) MaxUses() int64 {
+	return cast.ToInt64(d.Get(COLUMN_MAX_USES))
+}
+
+func (d *Discount) SetMaxUses(maxUses int64) DiscountInterface {
+	d.Set(COLUMN_MAX_USES, cast.ToString(maxUses))
+	return d
+}
+
+// MaxUsesPerCustomer returns how many times the discount may be redeemed by a customer, 0 is unlimited
+func (d *Discount) MaxUsesPerCustomer() int64 {
+	return cast.ToInt64(d.Get(COLUMN_MAX_USES_PER_CUSTOMER))
+}
+
+func (d *Discount) SetMaxUsesPerCustomer(maxUsesPerCustomer int64) DiscountInterface {
+	d.Set(COLUMN_MAX_USES_PER_CUSTOMER, cast.ToString(maxUsesPerCustomer))
+	return d
+}
+
 func (d *Discount) Memo() string {
 	return d.Get(COLUMN_MEMO)
 }
@@ -0,0 +1,85 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+)
+
+// == CLASS ====================================================================
+
+type DiscountRedemption struct {
+	dataobject.DataObject
+}
+
+var _ DiscountRedemptionInterface = (*DiscountRedemption)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewDiscountRedemption() DiscountRedemptionInterface {
+	o := (&DiscountRedemption{}).
+		SetID(uid.HumanUid()).
+		SetDiscountID("").
+		SetOrderID("").
+		SetCustomerID("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewDiscountRedemptionFromExistingData(data map[string]string) DiscountRedemptionInterface {
+	o := &DiscountRedemption{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *DiscountRedemption) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *DiscountRedemption) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *DiscountRedemption) SetCreatedAt(createdAt string) DiscountRedemptionInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *DiscountRedemption) CustomerID() string {
+	return o.Get(COLUMN_CUSTOMER_ID)
+}
+
+func (o *DiscountRedemption) SetCustomerID(customerID string) DiscountRedemptionInterface {
+	o.Set(COLUMN_CUSTOMER_ID, customerID)
+	return o
+}
+
+func (o *DiscountRedemption) DiscountID() string {
+	return o.Get(COLUMN_DISCOUNT_ID)
+}
+
+func (o *DiscountRedemption) SetDiscountID(discountID string) DiscountRedemptionInterface {
+	o.Set(COLUMN_DISCOUNT_ID, discountID)
+	return o
+}
+
+func (o *DiscountRedemption) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *DiscountRedemption) SetID(id string) DiscountRedemptionInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *DiscountRedemption) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *DiscountRedemption) SetOrderID(orderID string) DiscountRedemptionInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
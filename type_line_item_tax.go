@@ -0,0 +1,22 @@
+package shopstore
+
+// == CLASS ====================================================================
+
+// LineItemTax is the tax of an order line item,
+// as returned by Store.TaxCalculateLineItems
+type LineItemTax struct {
+	LineItem OrderLineItemInterface
+
+	// TaxRate is the rate applied to the line item, nil if no rate applies
+	TaxRate TaxRateInterface
+
+	// Tax is the tax of the line item price times its quantity
+	Tax Money
+}
+
+// == METHODS ==================================================================
+
+// IsInclusive returns true if the tax is included in the line item price
+func (lineItemTax LineItemTax) IsInclusive() bool {
+	return lineItemTax.TaxRate != nil && lineItemTax.TaxRate.IsInclusive()
+}
@@ -0,0 +1,317 @@
+package shopstore
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MONEY_CURRENCY_DEFAULT is the currency used by new products, orders,
+// order line items and discounts, unless set otherwise
+const MONEY_CURRENCY_DEFAULT = "USD"
+
+// MONEY_DECIMALS is the number of decimals (minor units) money is stored with,
+// matching the DECIMAL(10,2) price and amount columns
+const MONEY_DECIMALS = 2
+
+const moneyMinorUnitsPerUnit = 100
+
+// == CLASS ====================================================================
+
+// Money is an exact amount of money, kept as an integer number of minor
+// units (i.e. cents) and a currency code. Money is immutable, all the
+// arithmetic methods return a new value. Adding, subtracting or comparing
+// amounts in different currencies is a programming error and panics,
+// callers check SameCurrency first where the currencies come from data.
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// == CONSTRUCTORS =============================================================
+
+// NewMoney creates money from an amount in minor units (i.e. 1999 is 19.99)
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		amount:   amount,
+		currency: strings.ToUpper(strings.TrimSpace(currency)),
+	}
+}
+
+// NewMoneyFromFloat creates money from a float, rounded half away from zero
+// to the minor units
+func NewMoneyFromFloat(amount float64, currency string) Money {
+	return NewMoney(int64(math.Round(amount*moneyMinorUnitsPerUnit)), currency)
+}
+
+// NewMoneyFromString creates money from a decimal string (i.e. "19.99",
+// "-5", "19.9900"), rounded half away from zero to the minor units.
+// An empty string is zero.
+func NewMoneyFromString(amount string, currency string) (Money, error) {
+	minor, err := decimalParse(amount, MONEY_DECIMALS)
+
+	if err != nil {
+		return Money{}, errors.New("money: " + err.Error())
+	}
+
+	return NewMoney(minor, currency), nil
+}
+
+// == METHODS ==================================================================
+
+// Add returns the sum of the two amounts, panics if the currencies differ
+func (m Money) Add(other Money) Money {
+	m.mustSameCurrency(other)
+	return NewMoney(m.amount+other.amount, m.currency)
+}
+
+// Sub returns the difference of the two amounts, panics if the currencies differ
+func (m Money) Sub(other Money) Money {
+	m.mustSameCurrency(other)
+	return NewMoney(m.amount-other.amount, m.currency)
+}
+
+// Mul returns the amount multiplied by the quantity
+func (m Money) Mul(quantity int64) Money {
+	return NewMoney(m.amount*quantity, m.currency)
+}
+
+// Percent returns the given percent of the amount, the percent being
+// expressed in hundredths (i.e. 1250 is 12.50%), rounded half away from zero
+func (m Money) Percent(hundredthsOfPercent int64) Money {
+	return NewMoney(divRound(m.amount*hundredthsOfPercent, 10000), m.currency)
+}
+
+// Min returns the smaller of the two amounts, panics if the currencies differ
+func (m Money) Min(other Money) Money {
+	m.mustSameCurrency(other)
+
+	if other.amount < m.amount {
+		return NewMoney(other.amount, m.currency)
+	}
+
+	return m
+}
+
+// Cmp compares the amounts, returning -1, 0 or +1,
+// panics if the currencies differ
+func (m Money) Cmp(other Money) int {
+	m.mustSameCurrency(other)
+
+	switch {
+	case m.amount < other.amount:
+		return -1
+	case m.amount > other.amount:
+		return 1
+	}
+
+	return 0
+}
+
+// Equals returns whether the amounts and the currencies are equal
+func (m Money) Equals(other Money) bool {
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+// SameCurrency returns whether the amounts are in the same currency,
+// so they can be added, subtracted and compared
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency == other.currency
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// == GETTERS ==================================================================
+
+// Amount returns the amount in minor units (i.e. 1999 for 19.99)
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Float returns the amount as a float, use only for display
+func (m Money) Float() float64 {
+	return float64(m.amount) / moneyMinorUnitsPerUnit
+}
+
+// String returns the amount as a decimal string (i.e. "19.99"),
+// which is how money is stored in the database
+func (m Money) String() string {
+	amount := m.amount
+	sign := ""
+
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	units := strconv.FormatInt(amount/moneyMinorUnitsPerUnit, 10)
+	fraction := strconv.FormatInt(amount%moneyMinorUnitsPerUnit, 10)
+	fraction = strings.Repeat("0", MONEY_DECIMALS-len(fraction)) + fraction
+
+	return sign + units + "." + fraction
+}
+
+// == PRIVATE FUNCTIONS ========================================================
+
+// decimalParse parses a decimal string (i.e. "19.99", "-5", "8.875") into an
+// integer scaled by 10^decimals, rounded half away from zero. An empty string is zero.
+func decimalParse(value string, decimals int) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return 0, nil
+	}
+
+	negative := false
+
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		negative = value[0] == '-'
+		value = value[1:]
+	}
+
+	units, fraction, _ := strings.Cut(value, ".")
+
+	if units == "" && fraction == "" {
+		return 0, errors.New("invalid decimal")
+	}
+
+	for _, part := range []string{units, fraction} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return 0, errors.New("invalid decimal " + value)
+			}
+		}
+	}
+
+	unitsInt := int64(0)
+
+	if units != "" {
+		var err error
+		unitsInt, err = strconv.ParseInt(units, 10, 64)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Pad or cut the fraction to the decimals, remembering
+	// the first cut digit for rounding
+	roundUp := len(fraction) > decimals && fraction[decimals] >= '5'
+
+	if len(fraction) > decimals {
+		fraction = fraction[:decimals]
+	}
+
+	fraction += strings.Repeat("0", decimals-len(fraction))
+
+	fractionInt := int64(0)
+
+	if fraction != "" {
+		var err error
+		fractionInt, err = strconv.ParseInt(fraction, 10, 64)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	scaled := unitsInt*int64(math.Pow10(decimals)) + fractionInt
+
+	if roundUp {
+		scaled++
+	}
+
+	if negative {
+		scaled = -scaled
+	}
+
+	return scaled, nil
+}
+
+// moneySpread splits the amount across the parts in proportion to them,
+// by largest remainder so the shares add up to the amount exactly.
+// No part gets a share larger than itself, the amount is capped at
+// the sum of the parts.
+func moneySpread(amount Money, parts []Money) []Money {
+	shares := make([]Money, len(parts))
+	remainders := make([]int64, len(parts))
+
+	sum := int64(0)
+
+	for _, part := range parts {
+		sum += part.Amount()
+	}
+
+	spread := min(amount.Amount(), sum)
+	allocated := int64(0)
+
+	for i, part := range parts {
+		shares[i] = NewMoney(0, amount.Currency())
+
+		if spread <= 0 || part.Amount() <= 0 {
+			continue
+		}
+
+		shares[i] = NewMoney(spread*part.Amount()/sum, amount.Currency())
+		remainders[i] = spread * part.Amount() % sum
+		allocated += shares[i].Amount()
+	}
+
+	indexes := make([]int, len(parts))
+
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return remainders[indexes[a]] > remainders[indexes[b]]
+	})
+
+	for _, i := range indexes {
+		if allocated >= spread {
+			break
+		}
+
+		shares[i] = shares[i].Add(NewMoney(1, amount.Currency()))
+		allocated++
+	}
+
+	return shares
+}
+
+// mustSameCurrency panics if the amounts are in different currencies
+func (m Money) mustSameCurrency(other Money) {
+	if !m.SameCurrency(other) {
+		panic("money: currency mismatch, " + m.currency + " and " + other.currency)
+	}
+}
+
+// divRound divides a by b (b > 0), rounding half away from zero
+func divRound(a int64, b int64) int64 {
+	result := a / b
+	remainder := a % b
+
+	if remainder*2 >= b {
+		result++
+	} else if remainder*2 <= -b {
+		result--
+	}
+
+	return result
+}
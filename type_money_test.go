@@ -0,0 +1,150 @@
+package shopstore
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMoneyFromString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"", 0},
+		{"0", 0},
+		{"19.99", 1999},
+		{"19.9900", 1999},
+		{"19.995", 2000},
+		{"19.994", 1999},
+		{".5", 50},
+		{"7", 700},
+		{"-5.25", -525},
+		{"+1.10", 110},
+	}
+
+	for _, test := range tests {
+		money, err := NewMoneyFromString(test.input, "usd")
+
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if money.Amount() != test.expected {
+			t.Fatal("Money", test.input, "MUST be", test.expected, "minor units, found:", money.Amount())
+		}
+
+		if money.Currency() != "USD" {
+			t.Fatal("Money currency MUST be USD, found:", money.Currency())
+		}
+	}
+
+	for _, input := range []string{"abc", "1.2.3", "-", "1e5"} {
+		if _, err := NewMoneyFromString(input, "USD"); err == nil {
+			t.Fatal("expected error, invalid amount:", input)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	price := NewMoney(1999, "EUR")
+
+	if price.Mul(3).String() != "59.97" {
+		t.Fatal("Money MUST be 59.97, found:", price.Mul(3).String())
+	}
+
+	// 0.1 + 0.2 is not 0.3 with floats
+	sum := NewMoneyFromFloat(0.1, "EUR").Add(NewMoneyFromFloat(0.2, "EUR"))
+
+	if !sum.Equals(NewMoney(30, "EUR")) {
+		t.Fatal("Money MUST be 0.30, found:", sum.String())
+	}
+
+	if NewMoney(-5, "EUR").String() != "-0.05" {
+		t.Fatal("Money MUST be -0.05, found:", NewMoney(-5, "EUR").String())
+	}
+
+	// 12.5% of 0.99 is 0.12375
+	if price.Sub(NewMoney(1900, "EUR")).Percent(1250).Amount() != 12 {
+		t.Fatal("Money MUST be 0.12, found:", price.Sub(NewMoney(1900, "EUR")).Percent(1250).String())
+	}
+}
+
+func TestMoneyCurrencyMismatch(t *testing.T) {
+	euros := NewMoney(1000, "EUR")
+	dollars := NewMoney(1000, "USD")
+
+	if euros.SameCurrency(dollars) || !euros.SameCurrency(NewMoney(5, "EUR")) {
+		t.Fatal("Money MUST be in the same currency only when the currencies are equal")
+	}
+
+	operations := map[string]func(){
+		"Add": func() { euros.Add(dollars) },
+		"Sub": func() { euros.Sub(dollars) },
+		"Min": func() { euros.Min(dollars) },
+		"Cmp": func() { euros.Cmp(dollars) },
+	}
+
+	for name, operation := range operations {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("Money " + name + " MUST panic when the currencies differ")
+				}
+			}()
+
+			operation()
+		}()
+	}
+}
+
+func TestStoreProductPriceMoney(t *testing.T) {
+	store, err := initStore(":memory:")
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ctx := context.Background()
+
+	product := NewProduct().
+		SetTitle("Product 1").
+		SetPriceMoney(NewMoney(1999, "GBP"))
+
+	if err := store.ProductCreate(ctx, product); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	productFound, err := store.ProductFindByID(ctx, product.ID())
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !productFound.PriceMoney().Equals(NewMoney(1999, "GBP")) {
+		t.Fatal("Product price MUST be 19.99 GBP, found:", productFound.PriceMoney().String(), productFound.PriceMoney().Currency())
+	}
+
+	if productFound.PriceFloat() != 19.99 {
+		t.Fatal("Product price float MUST be 19.99, found:", productFound.PriceFloat())
+	}
+}
+
+func TestMoneySpread(t *testing.T) {
+	parts := []Money{NewMoney(100, "USD"), NewMoney(100, "USD"), NewMoney(100, "USD")}
+
+	shares := moneySpread(NewMoney(100, "USD"), parts)
+
+	if shares[0].Amount()+shares[1].Amount()+shares[2].Amount() != 100 {
+		t.Fatal("Shares MUST add up to 1.00, found:", shares)
+	}
+
+	if shares[0].Amount() != 34 || shares[1].Amount() != 33 || shares[2].Amount() != 33 {
+		t.Fatal("Shares MUST be 0.34, 0.33 and 0.33, found:", shares)
+	}
+
+	shares = moneySpread(NewMoney(500, "USD"), []Money{NewMoney(100, "USD"), NewMoney(0, "USD")})
+
+	if shares[0].Amount() != 100 || shares[1].Amount() != 0 {
+		t.Fatal("Shares MUST be capped at the parts, found:", shares)
+	}
+}
@@ -1,6 +1,8 @@
 package shopstore
 
 import (
+	"encoding/json"
+
 	"github.com/dromara/carbon/v2"
 	"github.com/gouniverse/dataobject"
 	"github.com/gouniverse/maputils"
@@ -25,6 +27,14 @@ func NewOrder() OrderInterface {
 		SetStatus(ORDER_STATUS_PENDING).
 		SetQuantityInt(1). // By default 1
 		SetPriceFloat(0).  // Free. By default
+		SetCurrency(MONEY_CURRENCY_DEFAULT).
+		SetDiscountTotal("0.00").
+		SetGrandTotal("0.00").
+		SetShippingTotal("0.00").
+		SetSubtotal("0.00").
+		SetTaxTotal("0.00").
+		SetBillingAddress(nil).  // No address. By default
+		SetShippingAddress(nil). // No address. By default
 		SetMemo("").
 		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
 		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
@@ -93,6 +103,20 @@ func (order *Order) IsShipped() bool {
 
 // == GETTERS & SETTERS ========================================================
 
+// BillingAddress returns the copy of the billing address taken when the
+// order was placed, nil if none. Later changes to the address book do
+// not affect it.
+func (order *Order) BillingAddress() AddressInterface {
+	return orderAddressSnapshotGet(order.Get(COLUMN_BILLING_ADDRESS))
+}
+
+// SetBillingAddress stores a copy of the address as the billing address
+// of the order, nil removes it
+func (order *Order) SetBillingAddress(address AddressInterface) OrderInterface {
+	order.Set(COLUMN_BILLING_ADDRESS, orderAddressSnapshot(address))
+	return order
+}
+
 func (order *Order) CreatedAt() string {
 	return order.Get(COLUMN_CREATED_AT)
 }
@@ -106,6 +130,16 @@ func (order *Order) SetCreatedAt(createdAt string) OrderInterface {
 	return order
 }
 
+// Currency returns the ISO 4217 currency code of the price
+func (order *Order) Currency() string {
+	return order.Get(COLUMN_CURRENCY)
+}
+
+func (order *Order) SetCurrency(currency string) OrderInterface {
+	order.Set(COLUMN_CURRENCY, currency)
+	return order
+}
+
 func (order *Order) CustomerID() string {
 	return order.Get(COLUMN_CUSTOMER_ID)
 }
@@ -219,6 +253,24 @@ func (order *Order) SetPriceFloat(price float64) OrderInterface {
 	return order
 }
 
+// PriceMoney returns the price as exact money in the currency of the order
+func (order *Order) PriceMoney() Money {
+	price, err := NewMoneyFromString(order.Price(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the order
+func (order *Order) SetPriceMoney(price Money) OrderInterface {
+	order.SetPrice(price.String())
+	order.SetCurrency(price.Currency())
+	return order
+}
+
 func (order *Order) Quantity() string {
 	return order.Get(COLUMN_QUANTITY)
 }
@@ -263,3 +315,167 @@ func (order *Order) SetUpdatedAt(updatedAt string) OrderInterface {
 	order.Set(COLUMN_UPDATED_AT, updatedAt)
 	return order
 }
+
+// DiscountTotal returns the total discount of the order (see Store.OrderRecalculate)
+func (order *Order) DiscountTotal() string {
+	return order.Get(COLUMN_DISCOUNT_TOTAL)
+}
+
+func (order *Order) SetDiscountTotal(discountTotal string) OrderInterface {
+	order.Set(COLUMN_DISCOUNT_TOTAL, discountTotal)
+	return order
+}
+
+func (order *Order) DiscountTotalMoney() Money {
+	discountTotal, err := NewMoneyFromString(order.DiscountTotal(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return discountTotal
+}
+
+func (order *Order) SetDiscountTotalMoney(discountTotal Money) OrderInterface {
+	return order.SetDiscountTotal(discountTotal.String())
+}
+
+// GrandTotal returns the total the customer pays: subtotal - discount + tax + shipping (see Store.OrderRecalculate)
+func (order *Order) GrandTotal() string {
+	return order.Get(COLUMN_GRAND_TOTAL)
+}
+
+func (order *Order) SetGrandTotal(grandTotal string) OrderInterface {
+	order.Set(COLUMN_GRAND_TOTAL, grandTotal)
+	return order
+}
+
+func (order *Order) GrandTotalMoney() Money {
+	grandTotal, err := NewMoneyFromString(order.GrandTotal(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return grandTotal
+}
+
+func (order *Order) SetGrandTotalMoney(grandTotal Money) OrderInterface {
+	return order.SetGrandTotal(grandTotal.String())
+}
+
+// ShippingAddress returns the copy of the shipping address taken when the
+// order was placed, nil if none. Later changes to the address book do
+// not affect it.
+func (order *Order) ShippingAddress() AddressInterface {
+	return orderAddressSnapshotGet(order.Get(COLUMN_SHIPPING_ADDRESS))
+}
+
+// SetShippingAddress stores a copy of the address as the shipping address
+// of the order, nil removes it
+func (order *Order) SetShippingAddress(address AddressInterface) OrderInterface {
+	order.Set(COLUMN_SHIPPING_ADDRESS, orderAddressSnapshot(address))
+	return order
+}
+
+// ShippingTotal returns the shipping cost of the order (see Store.OrderRecalculate)
+func (order *Order) ShippingTotal() string {
+	return order.Get(COLUMN_SHIPPING_TOTAL)
+}
+
+func (order *Order) SetShippingTotal(shippingTotal string) OrderInterface {
+	order.Set(COLUMN_SHIPPING_TOTAL, shippingTotal)
+	return order
+}
+
+func (order *Order) ShippingTotalMoney() Money {
+	shippingTotal, err := NewMoneyFromString(order.ShippingTotal(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return shippingTotal
+}
+
+func (order *Order) SetShippingTotalMoney(shippingTotal Money) OrderInterface {
+	return order.SetShippingTotal(shippingTotal.String())
+}
+
+// Subtotal returns the sum of the line item prices times their quantities (see Store.OrderRecalculate)
+func (order *Order) Subtotal() string {
+	return order.Get(COLUMN_SUBTOTAL)
+}
+
+func (order *Order) SetSubtotal(subtotal string) OrderInterface {
+	order.Set(COLUMN_SUBTOTAL, subtotal)
+	return order
+}
+
+func (order *Order) SubtotalMoney() Money {
+	subtotal, err := NewMoneyFromString(order.Subtotal(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return subtotal
+}
+
+func (order *Order) SetSubtotalMoney(subtotal Money) OrderInterface {
+	return order.SetSubtotal(subtotal.String())
+}
+
+// TaxTotal returns the total tax of the order (see Store.OrderRecalculate)
+func (order *Order) TaxTotal() string {
+	return order.Get(COLUMN_TAX_TOTAL)
+}
+
+func (order *Order) SetTaxTotal(taxTotal string) OrderInterface {
+	order.Set(COLUMN_TAX_TOTAL, taxTotal)
+	return order
+}
+
+func (order *Order) TaxTotalMoney() Money {
+	taxTotal, err := NewMoneyFromString(order.TaxTotal(), order.Currency())
+
+	if err != nil {
+		return NewMoney(0, order.Currency())
+	}
+
+	return taxTotal
+}
+
+func (order *Order) SetTaxTotalMoney(taxTotal Money) OrderInterface {
+	return order.SetTaxTotal(taxTotal.String())
+}
+
+// orderAddressSnapshot returns the address data as json string
+func orderAddressSnapshot(address AddressInterface) string {
+	if address == nil {
+		return ""
+	}
+
+	snapshot, err := json.Marshal(address.Data())
+
+	if err != nil {
+		return ""
+	}
+
+	return string(snapshot)
+}
+
+// orderAddressSnapshotGet returns the address stored as json string
+func orderAddressSnapshotGet(snapshot string) AddressInterface {
+	if snapshot == "" {
+		return nil
+	}
+
+	data := map[string]string{}
+
+	if err := json.Unmarshal([]byte(snapshot), &data); err != nil {
+		return nil
+	}
+
+	return NewAddressFromExistingData(data)
+}
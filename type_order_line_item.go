@@ -24,8 +24,10 @@ func NewOrderLineItem() OrderLineItemInterface {
 		SetID(uid.HumanUid()).
 		SetStatus(ORDER_STATUS_PENDING).
 		SetTitle("").
+		SetVariantID("").
 		SetQuantityInt(1). // By default 1
 		SetPriceFloat(0).  // Free. By default
+		SetCurrency(MONEY_CURRENCY_DEFAULT).
 		SetMemo("").
 		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
 		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
@@ -57,6 +59,16 @@ func (o *OrderLineItem) SetCreatedAt(createdAt string) OrderLineItemInterface {
 	return o
 }
 
+// Currency returns the ISO 4217 currency code the line item was priced in
+func (o *OrderLineItem) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *OrderLineItem) SetCurrency(currency string) OrderLineItemInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
 func (o *OrderLineItem) ID() string {
 	return o.Get(COLUMN_ID)
 }
@@ -162,6 +174,24 @@ func (o *OrderLineItem) SetPriceFloat(price float64) OrderLineItemInterface {
 	return o
 }
 
+// PriceMoney returns the price as exact money in the currency of the order line item
+func (o *OrderLineItem) PriceMoney() Money {
+	price, err := NewMoneyFromString(o.Price(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the order line item
+func (o *OrderLineItem) SetPriceMoney(price Money) OrderLineItemInterface {
+	o.SetPrice(price.String())
+	o.SetCurrency(price.Currency())
+	return o
+}
+
 func (o *OrderLineItem) ProductID() string {
 	return o.Get(COLUMN_PRODUCT_ID)
 }
@@ -235,6 +265,17 @@ func (o *OrderLineItem) SetUpdatedAt(updatedAt string) OrderLineItemInterface {
 	return o
 }
 
+// VariantID returns the ID of the ordered variant of the product,
+// or an empty string if the product has no variants
+func (o *OrderLineItem) VariantID() string {
+	return o.Get(COLUMN_VARIANT_ID)
+}
+
+func (o *OrderLineItem) SetVariantID(variantID string) OrderLineItemInterface {
+	o.Set(COLUMN_VARIANT_ID, variantID)
+	return o
+}
+
 // type LineItem struct {
 // 	ID       string
 // 	OrdeID   string
@@ -0,0 +1,105 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+)
+
+// == CLASS ====================================================================
+
+type OrderStatusHistory struct {
+	dataobject.DataObject
+}
+
+var _ OrderStatusHistoryInterface = (*OrderStatusHistory)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewOrderStatusHistory() OrderStatusHistoryInterface {
+	o := (&OrderStatusHistory{}).
+		SetID(uid.HumanUid()).
+		SetOrderID("").
+		SetFromStatus("").
+		SetToStatus("").
+		SetActor(""). // By default empty, unknown actor
+		SetNote("").  // By default empty
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewOrderStatusHistoryFromExistingData(data map[string]string) OrderStatusHistoryInterface {
+	o := &OrderStatusHistory{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *OrderStatusHistory) Actor() string {
+	return o.Get(COLUMN_ACTOR)
+}
+
+func (o *OrderStatusHistory) SetActor(actor string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_ACTOR, actor)
+	return o
+}
+
+func (o *OrderStatusHistory) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *OrderStatusHistory) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *OrderStatusHistory) SetCreatedAt(createdAt string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *OrderStatusHistory) FromStatus() string {
+	return o.Get(COLUMN_FROM_STATUS)
+}
+
+func (o *OrderStatusHistory) SetFromStatus(fromStatus string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_FROM_STATUS, fromStatus)
+	return o
+}
+
+func (o *OrderStatusHistory) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *OrderStatusHistory) SetID(id string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *OrderStatusHistory) Note() string {
+	return o.Get(COLUMN_NOTE)
+}
+
+func (o *OrderStatusHistory) SetNote(note string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_NOTE, note)
+	return o
+}
+
+func (o *OrderStatusHistory) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *OrderStatusHistory) SetOrderID(orderID string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+func (o *OrderStatusHistory) ToStatus() string {
+	return o.Get(COLUMN_TO_STATUS)
+}
+
+func (o *OrderStatusHistory) SetToStatus(toStatus string) OrderStatusHistoryInterface {
+	o.Set(COLUMN_TO_STATUS, toStatus)
+	return o
+}
@@ -0,0 +1,106 @@
+package shopstore
+
+// == CLASS ====================================================================
+
+// OrderStatusTransitionError is returned when an order is asked to move
+// to a status which is not reachable from its current status
+type OrderStatusTransitionError struct {
+	OrderID    string
+	FromStatus string
+	ToStatus   string
+}
+
+var _ error = (*OrderStatusTransitionError)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+// DefaultOrderStatusTransitions returns the order lifecycle used when
+// no custom transitions are set in NewStoreOptions. The keys are the
+// current statuses, the values the statuses an order may move to.
+func DefaultOrderStatusTransitions() map[string][]string {
+	return map[string][]string{
+		ORDER_STATUS_PENDING: {
+			ORDER_STATUS_AWAITING_PAYMENT,
+			ORDER_STATUS_AWAITING_FULFILLMENT,
+			ORDER_STATUS_MANUAL_VERIFICATION_REQUIRED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_DECLINED,
+		},
+		ORDER_STATUS_AWAITING_PAYMENT: {
+			ORDER_STATUS_AWAITING_FULFILLMENT,
+			ORDER_STATUS_MANUAL_VERIFICATION_REQUIRED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_DECLINED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_MANUAL_VERIFICATION_REQUIRED: {
+			ORDER_STATUS_AWAITING_PAYMENT,
+			ORDER_STATUS_AWAITING_FULFILLMENT,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_DECLINED,
+		},
+		ORDER_STATUS_AWAITING_FULFILLMENT: {
+			ORDER_STATUS_AWAITING_SHIPMENT,
+			ORDER_STATUS_AWAITING_PICKUP,
+			ORDER_STATUS_PARTIALLY_SHIPPED,
+			ORDER_STATUS_SHIPPED,
+			ORDER_STATUS_COMPLETED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_AWAITING_SHIPMENT: {
+			ORDER_STATUS_PARTIALLY_SHIPPED,
+			ORDER_STATUS_SHIPPED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_AWAITING_PICKUP: {
+			ORDER_STATUS_COMPLETED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_PARTIALLY_SHIPPED: {
+			ORDER_STATUS_SHIPPED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_SHIPPED: {
+			ORDER_STATUS_COMPLETED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_COMPLETED: {
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_PARTIALLY_REFUNDED: {
+			ORDER_STATUS_REFUNDED,
+			ORDER_STATUS_DISPUTED,
+		},
+		ORDER_STATUS_DISPUTED: {
+			ORDER_STATUS_AWAITING_FULFILLMENT,
+			ORDER_STATUS_COMPLETED,
+			ORDER_STATUS_CANCELLED,
+			ORDER_STATUS_PARTIALLY_REFUNDED,
+			ORDER_STATUS_REFUNDED,
+		},
+		ORDER_STATUS_CANCELLED: {},
+		ORDER_STATUS_DECLINED:  {},
+		ORDER_STATUS_REFUNDED:  {},
+	}
+}
+
+// == METHODS ==================================================================
+
+func (e *OrderStatusTransitionError) Error() string {
+	return "order " + e.OrderID + ": transition from status '" + e.FromStatus + "' to status '" + e.ToStatus + "' is not allowed"
+}
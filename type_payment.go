@@ -0,0 +1,260 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CONSTANTS ==============================================================
+
+const PAYMENT_STATUS_PENDING = "pending"
+const PAYMENT_STATUS_AUTHORIZED = "authorized"
+const PAYMENT_STATUS_CAPTURED = "captured"
+const PAYMENT_STATUS_FAILED = "failed"
+const PAYMENT_STATUS_CANCELLED = "cancelled"
+
+// PAYMENT_META_RAW_PAYLOAD is the meta holding the raw payload
+// received from the payment provider, as is
+const PAYMENT_META_RAW_PAYLOAD = "raw_payload"
+
+// == CLASS ==================================================================
+
+// Payment is a payment for an order taken through a payment provider
+type Payment struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES =============================================================
+
+var _ PaymentInterface = (*Payment)(nil)
+
+// == CONSTRUCTORS ===========================================================
+
+func NewPayment() PaymentInterface {
+	o := (&Payment{}).
+		SetID(uid.HumanUid()).
+		SetStatus(PAYMENT_STATUS_PENDING).
+		SetOrderID("").
+		SetProvider("").
+		SetProviderReference("").
+		SetAmountMoney(NewMoney(0, MONEY_CURRENCY_DEFAULT)).
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewPaymentFromExistingData(data map[string]string) PaymentInterface {
+	o := &Payment{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ================================================================
+
+// IsCaptured returns true if the money of the payment has been taken
+func (o *Payment) IsCaptured() bool {
+	return o.Status() == PAYMENT_STATUS_CAPTURED
+}
+
+// == SETTERS AND GETTERS ====================================================
+
+func (o *Payment) Amount() string {
+	return o.Get(COLUMN_AMOUNT)
+}
+
+func (o *Payment) SetAmount(amount string) PaymentInterface {
+	o.Set(COLUMN_AMOUNT, amount)
+	return o
+}
+
+// AmountMoney returns the amount as exact money in the currency of the payment
+func (o *Payment) AmountMoney() Money {
+	amount, err := NewMoneyFromString(o.Amount(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return amount
+}
+
+// SetAmountMoney sets the amount and the currency of the payment
+func (o *Payment) SetAmountMoney(amount Money) PaymentInterface {
+	o.SetAmount(amount.String())
+	o.SetCurrency(amount.Currency())
+	return o
+}
+
+func (o *Payment) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Payment) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Payment) SetCreatedAt(createdAt string) PaymentInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Payment) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *Payment) SetCurrency(currency string) PaymentInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *Payment) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Payment) SetID(id string) PaymentInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Payment) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Payment) SetMemo(memo string) PaymentInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Payment) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *Payment) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *Payment) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *Payment) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *Payment) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+func (o *Payment) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *Payment) SetOrderID(orderID string) PaymentInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+// Provider returns the name of the payment provider, i.e. stripe, paypal
+func (o *Payment) Provider() string {
+	return o.Get(COLUMN_PROVIDER)
+}
+
+func (o *Payment) SetProvider(provider string) PaymentInterface {
+	o.Set(COLUMN_PROVIDER, provider)
+	return o
+}
+
+// ProviderReference returns the ID of the payment at the payment provider
+func (o *Payment) ProviderReference() string {
+	return o.Get(COLUMN_PROVIDER_REFERENCE)
+}
+
+func (o *Payment) SetProviderReference(providerReference string) PaymentInterface {
+	o.Set(COLUMN_PROVIDER_REFERENCE, providerReference)
+	return o
+}
+
+func (o *Payment) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *Payment) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *Payment) SetSoftDeletedAt(deletedAt string) PaymentInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, deletedAt)
+	return o
+}
+
+func (o *Payment) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *Payment) SetStatus(status string) PaymentInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *Payment) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *Payment) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *Payment) SetUpdatedAt(updatedAt string) PaymentInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
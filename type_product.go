@@ -1,6 +1,8 @@
 package shopstore
 
 import (
+	"strconv"
+
 	"github.com/dromara/carbon/v2"
 	"github.com/gouniverse/dataobject"
 	"github.com/gouniverse/maputils"
@@ -29,8 +31,13 @@ func NewProduct() ProductInterface {
 		SetTitle("").
 		SetDescription("").
 		SetShortDescription("").
+		SetSKU("").
+		SetBarcode("").
+		SetSlug("").       // By default from the title, see ProductCreate
 		SetQuantityInt(0). // By default 0
 		SetPriceFloat(0).  // Free. By default
+		SetCurrency(MONEY_CURRENCY_DEFAULT).
+		SetWeight("0.000"). // By default weightless
 		SetMemo("").
 		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
 		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
@@ -69,12 +76,17 @@ func (product *Product) IsFree() bool {
 	return product.PriceFloat() <= 0
 }
 
-func (product *Product) Slug() string {
-	title := product.Title()
-	return strutils.Slugify(title, '-')
+// == GETTERS & SETTERS ========================================================
+
+// Barcode returns the barcode of the product, i.e. its EAN or UPC
+func (product *Product) Barcode() string {
+	return product.Get(COLUMN_BARCODE)
 }
 
-// == GETTERS & SETTERS ========================================================
+func (product *Product) SetBarcode(barcode string) ProductInterface {
+	product.Set(COLUMN_BARCODE, barcode)
+	return product
+}
 
 func (product *Product) CreatedAt() string {
 	return product.Get(COLUMN_CREATED_AT)
@@ -89,6 +101,16 @@ func (product *Product) SetCreatedAt(createdAt string) ProductInterface {
 	return product
 }
 
+// Currency returns the ISO 4217 currency code of the price
+func (product *Product) Currency() string {
+	return product.Get(COLUMN_CURRENCY)
+}
+
+func (product *Product) SetCurrency(currency string) ProductInterface {
+	product.Set(COLUMN_CURRENCY, currency)
+	return product
+}
+
 func (product *Product) Description() string {
 	return product.Get(COLUMN_DESCRIPTION)
 }
@@ -193,6 +215,24 @@ func (product *Product) SetPriceFloat(price float64) ProductInterface {
 	return product
 }
 
+// PriceMoney returns the price as exact money in the currency of the product
+func (product *Product) PriceMoney() Money {
+	price, err := NewMoneyFromString(product.Price(), product.Currency())
+
+	if err != nil {
+		return NewMoney(0, product.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the product
+func (product *Product) SetPriceMoney(price Money) ProductInterface {
+	product.SetPrice(price.String())
+	product.SetCurrency(price.Currency())
+	return product
+}
+
 func (product *Product) Quantity() string {
 	return product.Get(COLUMN_QUANTITY)
 }
@@ -221,6 +261,23 @@ func (product *Product) SetShortDescription(shortDescription string) ProductInte
 	return product
 }
 
+// Slug returns the slug of the product, or its slugified title
+// if the product has no slug set yet
+func (product *Product) Slug() string {
+	slug := product.Get(COLUMN_SLUG)
+
+	if slug != "" {
+		return slug
+	}
+
+	return strutils.Slugify(product.Title(), '-')
+}
+
+func (product *Product) SetSlug(slug string) ProductInterface {
+	product.Set(COLUMN_SLUG, slug)
+	return product
+}
+
 func (product *Product) SoftDeletedAt() string {
 	return product.Get(COLUMN_SOFT_DELETED_AT)
 }
@@ -234,6 +291,16 @@ func (product *Product) SetSoftDeletedAt(deletedAt string) ProductInterface {
 	return product
 }
 
+// SKU returns the stock keeping unit of the product
+func (product *Product) SKU() string {
+	return product.Get(COLUMN_SKU)
+}
+
+func (product *Product) SetSKU(sku string) ProductInterface {
+	product.Set(COLUMN_SKU, sku)
+	return product
+}
+
 func (product *Product) Status() string {
 	return product.Get(COLUMN_STATUS)
 }
@@ -264,3 +331,22 @@ func (product *Product) SetUpdatedAt(updatedAt string) ProductInterface {
 	product.Set(COLUMN_UPDATED_AT, updatedAt)
 	return product
 }
+
+// Weight returns the weight of the product in kilograms, i.e. "1.250"
+func (product *Product) Weight() string {
+	return product.Get(COLUMN_WEIGHT)
+}
+
+func (product *Product) SetWeight(weight string) ProductInterface {
+	product.Set(COLUMN_WEIGHT, weight)
+	return product
+}
+
+func (product *Product) WeightFloat() float64 {
+	weight, _ := utils.ToFloat(product.Weight())
+	return weight
+}
+
+func (product *Product) SetWeightFloat(weight float64) ProductInterface {
+	return product.SetWeight(strconv.FormatFloat(weight, 'f', WEIGHT_DECIMALS, 64))
+}
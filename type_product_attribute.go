@@ -0,0 +1,140 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CLASS ====================================================================
+
+// ProductAttribute is a named value of a product the products can be
+// filtered and faceted by, i.e. colour red or weight 2.5. Numeric values
+// are kept as a number too, for filtering by range.
+type ProductAttribute struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ ProductAttributeInterface = (*ProductAttribute)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewProductAttribute() ProductAttributeInterface {
+	o := (&ProductAttribute{}).
+		SetID(uid.HumanUid()).
+		SetProductID("").
+		SetName("").
+		SetValue("").
+		SetValueNumeric("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewProductAttributeFromExistingData(data map[string]string) ProductAttributeInterface {
+	o := &ProductAttribute{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+// HasValueNumeric returns true if the value is a number,
+// the attribute can then be filtered by range
+func (o *ProductAttribute) HasValueNumeric() bool {
+	return o.ValueNumeric() != ""
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *ProductAttribute) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ProductAttribute) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ProductAttribute) SetCreatedAt(createdAt string) ProductAttributeInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ProductAttribute) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ProductAttribute) SetID(id string) ProductAttributeInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ProductAttribute) Name() string {
+	return o.Get(COLUMN_NAME)
+}
+
+func (o *ProductAttribute) SetName(name string) ProductAttributeInterface {
+	o.Set(COLUMN_NAME, name)
+	return o
+}
+
+func (o *ProductAttribute) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *ProductAttribute) SetProductID(productID string) ProductAttributeInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *ProductAttribute) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ProductAttribute) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *ProductAttribute) SetUpdatedAt(updatedAt string) ProductAttributeInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
+
+func (o *ProductAttribute) Value() string {
+	return o.Get(COLUMN_VALUE)
+}
+
+func (o *ProductAttribute) SetValue(value string) ProductAttributeInterface {
+	o.Set(COLUMN_VALUE, value)
+	return o
+}
+
+func (o *ProductAttribute) ValueNumeric() string {
+	return o.Get(COLUMN_VALUE_NUMERIC)
+}
+
+func (o *ProductAttribute) SetValueNumeric(valueNumeric string) ProductAttributeInterface {
+	o.Set(COLUMN_VALUE_NUMERIC, valueNumeric)
+	return o
+}
+
+func (o *ProductAttribute) ValueNumericFloat() float64 {
+	valueNumeric, _ := utils.ToFloat(o.ValueNumeric())
+	return valueNumeric
+}
+
+// SetValueNumericFloat sets the numeric value, and the value
+// to the number too if not set already
+func (o *ProductAttribute) SetValueNumericFloat(valueNumeric float64) ProductAttributeInterface {
+	o.SetValueNumeric(utils.ToString(valueNumeric))
+
+	if o.Value() == "" {
+		o.SetValue(o.ValueNumeric())
+	}
+
+	return o
+}
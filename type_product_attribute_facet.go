@@ -0,0 +1,11 @@
+package shopstore
+
+// == CLASS ====================================================================
+
+// ProductAttributeFacet is the number of products having an attribute
+// value, as returned by Store.ProductAttributeFacets
+type ProductAttributeFacet struct {
+	Name  string
+	Value string
+	Count int64
+}
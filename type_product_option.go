@@ -0,0 +1,104 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+	"github.com/spf13/cast"
+)
+
+// == CLASS ====================================================================
+
+// ProductOption is an option the variants of a product differ in,
+// i.e. size or colour, with its values (see ProductOptionValue)
+type ProductOption struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ ProductOptionInterface = (*ProductOption)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewProductOption() ProductOptionInterface {
+	o := (&ProductOption{}).
+		SetID(uid.HumanUid()).
+		SetProductID("").
+		SetTitle("").
+		SetSequence(0).
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewProductOptionFromExistingData(data map[string]string) ProductOptionInterface {
+	o := &ProductOption{}
+	o.Hydrate(data)
+	return o
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *ProductOption) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ProductOption) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ProductOption) SetCreatedAt(createdAt string) ProductOptionInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ProductOption) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ProductOption) SetID(id string) ProductOptionInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ProductOption) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *ProductOption) SetProductID(productID string) ProductOptionInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *ProductOption) Sequence() int {
+	return cast.ToInt(o.Get(COLUMN_SEQUENCE))
+}
+
+func (o *ProductOption) SetSequence(sequence int) ProductOptionInterface {
+	o.Set(COLUMN_SEQUENCE, cast.ToString(sequence))
+	return o
+}
+
+func (o *ProductOption) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *ProductOption) SetTitle(title string) ProductOptionInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+func (o *ProductOption) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ProductOption) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *ProductOption) SetUpdatedAt(updatedAt string) ProductOptionInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -0,0 +1,104 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+	"github.com/spf13/cast"
+)
+
+// == CLASS ====================================================================
+
+// ProductOptionValue is one of the values of a product option,
+// i.e. XL for size or red for colour
+type ProductOptionValue struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ ProductOptionValueInterface = (*ProductOptionValue)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewProductOptionValue() ProductOptionValueInterface {
+	o := (&ProductOptionValue{}).
+		SetID(uid.HumanUid()).
+		SetOptionID("").
+		SetTitle("").
+		SetSequence(0).
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewProductOptionValueFromExistingData(data map[string]string) ProductOptionValueInterface {
+	o := &ProductOptionValue{}
+	o.Hydrate(data)
+	return o
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *ProductOptionValue) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ProductOptionValue) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ProductOptionValue) SetCreatedAt(createdAt string) ProductOptionValueInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ProductOptionValue) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ProductOptionValue) SetID(id string) ProductOptionValueInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ProductOptionValue) OptionID() string {
+	return o.Get(COLUMN_OPTION_ID)
+}
+
+func (o *ProductOptionValue) SetOptionID(optionID string) ProductOptionValueInterface {
+	o.Set(COLUMN_OPTION_ID, optionID)
+	return o
+}
+
+func (o *ProductOptionValue) Sequence() int {
+	return cast.ToInt(o.Get(COLUMN_SEQUENCE))
+}
+
+func (o *ProductOptionValue) SetSequence(sequence int) ProductOptionValueInterface {
+	o.Set(COLUMN_SEQUENCE, cast.ToString(sequence))
+	return o
+}
+
+func (o *ProductOptionValue) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *ProductOptionValue) SetTitle(title string) ProductOptionValueInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+func (o *ProductOptionValue) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ProductOptionValue) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *ProductOptionValue) SetUpdatedAt(updatedAt string) ProductOptionValueInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -0,0 +1,115 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+)
+
+// == CLASS ====================================================================
+
+// ProductPrice is the price of a product in a given currency
+type ProductPrice struct {
+	dataobject.DataObject
+}
+
+var _ ProductPriceInterface = (*ProductPrice)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewProductPrice() ProductPriceInterface {
+	o := (&ProductPrice{}).
+		SetID(uid.HumanUid()).
+		SetProductID("").
+		SetPriceMoney(NewMoney(0, MONEY_CURRENCY_DEFAULT)).
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewProductPriceFromExistingData(data map[string]string) ProductPriceInterface {
+	o := &ProductPrice{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *ProductPrice) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ProductPrice) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ProductPrice) SetCreatedAt(createdAt string) ProductPriceInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ProductPrice) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *ProductPrice) SetCurrency(currency string) ProductPriceInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *ProductPrice) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ProductPrice) SetID(id string) ProductPriceInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ProductPrice) Price() string {
+	return o.Get(COLUMN_PRICE)
+}
+
+func (o *ProductPrice) SetPrice(price string) ProductPriceInterface {
+	o.Set(COLUMN_PRICE, price)
+	return o
+}
+
+func (o *ProductPrice) PriceMoney() Money {
+	price, err := NewMoneyFromString(o.Price(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return price
+}
+
+func (o *ProductPrice) SetPriceMoney(price Money) ProductPriceInterface {
+	o.SetPrice(price.String())
+	o.SetCurrency(price.Currency())
+	return o
+}
+
+func (o *ProductPrice) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *ProductPrice) SetProductID(productID string) ProductPriceInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *ProductPrice) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ProductPrice) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *ProductPrice) SetUpdatedAt(updatedAt string) ProductPriceInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -0,0 +1,312 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+	"github.com/spf13/cast"
+)
+
+// == CONSTANTS ================================================================
+
+const PRODUCT_VARIANT_STATUS_ACTIVE = "active"
+const PRODUCT_VARIANT_STATUS_INACTIVE = "inactive"
+
+// == CLASS ====================================================================
+
+// ProductVariant is a sellable version of a product with its own SKU and
+// stock, i.e. the T-shirt in XL and red. It has the price of the product,
+// unless it sets a price of its own.
+type ProductVariant struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ ProductVariantInterface = (*ProductVariant)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewProductVariant() ProductVariantInterface {
+	o := (&ProductVariant{}).
+		SetID(uid.HumanUid()).
+		SetStatus(PRODUCT_VARIANT_STATUS_ACTIVE).
+		SetProductID("").
+		SetSKU("").
+		SetTitle("").
+		SetPrice("").
+		SetCurrency("").
+		SetQuantityInt(0).
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetAttributes(map[string]string{})
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewProductVariantFromExistingData(data map[string]string) ProductVariantInterface {
+	o := &ProductVariant{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+func (o *ProductVariant) IsActive() bool {
+	return o.Status() == PRODUCT_VARIANT_STATUS_ACTIVE
+}
+
+// HasPrice returns true if the variant sets a price of its own,
+// instead of having the price of the product
+func (o *ProductVariant) HasPrice() bool {
+	return o.Price() != ""
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+// Attributes returns the option values of the variant,
+// the option IDs mapped to the IDs of their values
+func (o *ProductVariant) Attributes() (map[string]string, error) {
+	attributesStr := o.Get(COLUMN_ATTRIBUTES)
+
+	if attributesStr == "" {
+		attributesStr = "{}"
+	}
+
+	attributesJson, errJson := utils.FromJSON(attributesStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(attributesJson.(map[string]any)), nil
+}
+
+// SetAttributes stores the option values of the variant as json string,
+// the option IDs mapped to the IDs of their values
+func (o *ProductVariant) SetAttributes(attributes map[string]string) error {
+	mapString, err := utils.ToJSON(attributes)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_ATTRIBUTES, mapString)
+
+	return nil
+}
+
+func (o *ProductVariant) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ProductVariant) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ProductVariant) SetCreatedAt(createdAt string) ProductVariantInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ProductVariant) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *ProductVariant) SetCurrency(currency string) ProductVariantInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *ProductVariant) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ProductVariant) SetID(id string) ProductVariantInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ProductVariant) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *ProductVariant) SetMemo(memo string) ProductVariantInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *ProductVariant) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *ProductVariant) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *ProductVariant) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *ProductVariant) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *ProductVariant) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+// Price returns the price of the variant,
+// or an empty string if it has the price of the product
+func (o *ProductVariant) Price() string {
+	return o.Get(COLUMN_PRICE)
+}
+
+func (o *ProductVariant) SetPrice(price string) ProductVariantInterface {
+	o.Set(COLUMN_PRICE, price)
+	return o
+}
+
+// PriceMoney returns the price as exact money in the currency of the variant
+func (o *ProductVariant) PriceMoney() Money {
+	price, err := NewMoneyFromString(o.Price(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the variant
+func (o *ProductVariant) SetPriceMoney(price Money) ProductVariantInterface {
+	o.SetPrice(price.String())
+	o.SetCurrency(price.Currency())
+	return o
+}
+
+func (o *ProductVariant) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *ProductVariant) SetProductID(productID string) ProductVariantInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *ProductVariant) Quantity() string {
+	return o.Get(COLUMN_QUANTITY)
+}
+
+func (o *ProductVariant) SetQuantity(quantity string) ProductVariantInterface {
+	o.Set(COLUMN_QUANTITY, quantity)
+	return o
+}
+
+func (o *ProductVariant) QuantityInt() int64 {
+	return cast.ToInt64(o.Quantity())
+}
+
+func (o *ProductVariant) SetQuantityInt(quantity int64) ProductVariantInterface {
+	return o.SetQuantity(cast.ToString(quantity))
+}
+
+func (o *ProductVariant) SKU() string {
+	return o.Get(COLUMN_SKU)
+}
+
+func (o *ProductVariant) SetSKU(sku string) ProductVariantInterface {
+	o.Set(COLUMN_SKU, sku)
+	return o
+}
+
+func (o *ProductVariant) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *ProductVariant) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *ProductVariant) SetSoftDeletedAt(softDeletedAt string) ProductVariantInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+func (o *ProductVariant) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *ProductVariant) SetStatus(status string) ProductVariantInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *ProductVariant) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *ProductVariant) SetTitle(title string) ProductVariantInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+func (o *ProductVariant) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ProductVariant) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *ProductVariant) SetUpdatedAt(updatedAt string) ProductVariantInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
@@ -0,0 +1,19 @@
+package shopstore
+
+// PurgeReport lists what PurgeStale touched
+type PurgeReport struct {
+	// CancelledOrderIDs are the IDs of the stale pending orders cancelled
+	CancelledOrderIDs []string
+
+	// SoftDeletedOrderIDs are the IDs of the stale pending orders soft
+	// deleted, because the order status transitions do not allow cancelling
+	SoftDeletedOrderIDs []string
+
+	// AbandonedCartIDs are the IDs of the expired carts marked as
+	// abandoned and soft deleted
+	AbandonedCartIDs []string
+
+	// ExpiredReservationIDs are the IDs of the stock reservations
+	// marked as expired
+	ExpiredReservationIDs []string
+}
@@ -0,0 +1,137 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+)
+
+// == CLASS ====================================================================
+
+// Refund is an amount paid back to the customer for an order, optionally
+// for a single line item of the order. Refunds are a ledger, once recorded
+// they are never changed.
+type Refund struct {
+	dataobject.DataObject
+}
+
+var _ RefundInterface = (*Refund)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewRefund() RefundInterface {
+	o := (&Refund{}).
+		SetID(uid.HumanUid()).
+		SetOrderID("").
+		SetOrderLineItemID(""). // By default the whole order
+		SetAmountMoney(NewMoney(0, MONEY_CURRENCY_DEFAULT)).
+		SetReason("").
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewRefundFromExistingData(data map[string]string) RefundInterface {
+	o := &Refund{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *Refund) Amount() string {
+	return o.Get(COLUMN_AMOUNT)
+}
+
+func (o *Refund) SetAmount(amount string) RefundInterface {
+	o.Set(COLUMN_AMOUNT, amount)
+	return o
+}
+
+// AmountMoney returns the amount as exact money in the currency of the refund
+func (o *Refund) AmountMoney() Money {
+	amount, err := NewMoneyFromString(o.Amount(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return amount
+}
+
+// SetAmountMoney sets the amount and the currency of the refund
+func (o *Refund) SetAmountMoney(amount Money) RefundInterface {
+	o.SetAmount(amount.String())
+	o.SetCurrency(amount.Currency())
+	return o
+}
+
+func (o *Refund) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Refund) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Refund) SetCreatedAt(createdAt string) RefundInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Refund) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *Refund) SetCurrency(currency string) RefundInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *Refund) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Refund) SetID(id string) RefundInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Refund) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Refund) SetMemo(memo string) RefundInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Refund) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *Refund) SetOrderID(orderID string) RefundInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+// OrderLineItemID returns the ID of the refunded line item,
+// empty if the refund is for the order as a whole
+func (o *Refund) OrderLineItemID() string {
+	return o.Get(COLUMN_ORDER_LINE_ITEM_ID)
+}
+
+func (o *Refund) SetOrderLineItemID(orderLineItemID string) RefundInterface {
+	o.Set(COLUMN_ORDER_LINE_ITEM_ID, orderLineItemID)
+	return o
+}
+
+func (o *Refund) Reason() string {
+	return o.Get(COLUMN_REASON)
+}
+
+func (o *Refund) SetReason(reason string) RefundInterface {
+	o.Set(COLUMN_REASON, reason)
+	return o
+}
@@ -0,0 +1,140 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+)
+
+// == CLASS ====================================================================
+
+// Shipment is a parcel sent for an order. Which order line items (and how
+// many of each) are in the parcel is kept in its shipment lines.
+type Shipment struct {
+	dataobject.DataObject
+}
+
+var _ ShipmentInterface = (*Shipment)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewShipment() ShipmentInterface {
+	o := (&Shipment{}).
+		SetID(uid.HumanUid()).
+		SetOrderID("").
+		SetCarrier("").
+		SetTrackingNumber("").
+		SetShippedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	return o
+}
+
+func NewShipmentFromExistingData(data map[string]string) ShipmentInterface {
+	o := &Shipment{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *Shipment) Carrier() string {
+	return o.Get(COLUMN_CARRIER)
+}
+
+func (o *Shipment) SetCarrier(carrier string) ShipmentInterface {
+	o.Set(COLUMN_CARRIER, carrier)
+	return o
+}
+
+func (o *Shipment) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *Shipment) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *Shipment) SetCreatedAt(createdAt string) ShipmentInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *Shipment) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *Shipment) SetID(id string) ShipmentInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *Shipment) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *Shipment) SetMemo(memo string) ShipmentInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *Shipment) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *Shipment) SetOrderID(orderID string) ShipmentInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+func (o *Shipment) ShippedAt() string {
+	return o.Get(COLUMN_SHIPPED_AT)
+}
+
+func (o *Shipment) ShippedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.ShippedAt(), carbon.UTC)
+}
+
+func (o *Shipment) SetShippedAt(shippedAt string) ShipmentInterface {
+	o.Set(COLUMN_SHIPPED_AT, shippedAt)
+	return o
+}
+
+func (o *Shipment) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *Shipment) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt(), carbon.UTC)
+}
+
+func (o *Shipment) SetSoftDeletedAt(softDeletedAt string) ShipmentInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, softDeletedAt)
+	return o
+}
+
+func (o *Shipment) TrackingNumber() string {
+	return o.Get(COLUMN_TRACKING_NUMBER)
+}
+
+func (o *Shipment) SetTrackingNumber(trackingNumber string) ShipmentInterface {
+	o.Set(COLUMN_TRACKING_NUMBER, trackingNumber)
+	return o
+}
+
+func (o *Shipment) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *Shipment) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *Shipment) SetUpdatedAt(updatedAt string) ShipmentInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
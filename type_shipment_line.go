@@ -0,0 +1,95 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+	"github.com/spf13/cast"
+)
+
+// == CLASS ====================================================================
+
+// ShipmentLine is the quantity of an order line item sent in a shipment
+type ShipmentLine struct {
+	dataobject.DataObject
+}
+
+var _ ShipmentLineInterface = (*ShipmentLine)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewShipmentLine() ShipmentLineInterface {
+	o := (&ShipmentLine{}).
+		SetID(uid.HumanUid()).
+		SetShipmentID("").
+		SetOrderLineItemID("").
+		SetQuantityInt(1). // By default 1
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewShipmentLineFromExistingData(data map[string]string) ShipmentLineInterface {
+	o := &ShipmentLine{}
+	o.Hydrate(data)
+	return o
+}
+
+// == GETTERS & SETTERS ========================================================
+
+func (o *ShipmentLine) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ShipmentLine) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *ShipmentLine) SetCreatedAt(createdAt string) ShipmentLineInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ShipmentLine) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ShipmentLine) SetID(id string) ShipmentLineInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ShipmentLine) OrderLineItemID() string {
+	return o.Get(COLUMN_ORDER_LINE_ITEM_ID)
+}
+
+func (o *ShipmentLine) SetOrderLineItemID(orderLineItemID string) ShipmentLineInterface {
+	o.Set(COLUMN_ORDER_LINE_ITEM_ID, orderLineItemID)
+	return o
+}
+
+func (o *ShipmentLine) Quantity() string {
+	return o.Get(COLUMN_QUANTITY)
+}
+
+func (o *ShipmentLine) SetQuantity(quantity string) ShipmentLineInterface {
+	o.Set(COLUMN_QUANTITY, quantity)
+	return o
+}
+
+func (o *ShipmentLine) QuantityInt() int64 {
+	return cast.ToInt64(o.Quantity())
+}
+
+func (o *ShipmentLine) SetQuantityInt(quantity int64) ShipmentLineInterface {
+	return o.SetQuantity(cast.ToString(quantity))
+}
+
+func (o *ShipmentLine) ShipmentID() string {
+	return o.Get(COLUMN_SHIPMENT_ID)
+}
+
+func (o *ShipmentLine) SetShipmentID(shipmentID string) ShipmentLineInterface {
+	o.Set(COLUMN_SHIPMENT_ID, shipmentID)
+	return o
+}
@@ -0,0 +1,424 @@
+package shopstore
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+	"github.com/samber/lo"
+)
+
+// == CONSTANTS ==============================================================
+
+const SHIPPING_METHOD_STATUS_DRAFT = "draft"
+const SHIPPING_METHOD_STATUS_ACTIVE = "active"
+const SHIPPING_METHOD_STATUS_INACTIVE = "inactive"
+
+// Same price for every order
+const SHIPPING_METHOD_TYPE_FLAT = "flat"
+
+// Price depends on the total weight of the order (see ShippingRateBand)
+const SHIPPING_METHOD_TYPE_WEIGHT = "weight"
+
+// Price depends on the subtotal of the order (see ShippingRateBand)
+const SHIPPING_METHOD_TYPE_PRICE = "price"
+
+// WEIGHT_DECIMALS is the number of decimals weights (in kilograms)
+// are stored with, i.e. grams
+const WEIGHT_DECIMALS = 3
+
+// == CLASS ==================================================================
+
+type ShippingMethod struct {
+	dataobject.DataObject
+}
+
+// ShippingRateBand is a price of a weight or price banded shipping method.
+// The band applies from its value (a weight in kilograms or an order
+// subtotal) until the value of the next band.
+type ShippingRateBand struct {
+	From  string `json:"from"`
+	Price string `json:"price"`
+}
+
+// == INTERFACES =============================================================
+
+var _ ShippingMethodInterface = (*ShippingMethod)(nil)
+
+// == CONSTRUCTORS ===========================================================
+
+func NewShippingMethod() ShippingMethodInterface {
+	o := (&ShippingMethod{}).
+		SetID(uid.HumanUid()).
+		SetStatus(SHIPPING_METHOD_STATUS_DRAFT).
+		SetType(SHIPPING_METHOD_TYPE_FLAT).
+		SetTitle("").
+		SetDescription("").
+		SetPriceMoney(NewMoney(0, MONEY_CURRENCY_DEFAULT)).
+		SetFreeShippingThreshold("0.00"). // By default no free shipping
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetRateBands([]ShippingRateBand{})
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewShippingMethodFromExistingData(data map[string]string) ShippingMethodInterface {
+	o := &ShippingMethod{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ================================================================
+
+// IsActive returns true if the shipping method is active
+func (o *ShippingMethod) IsActive() bool {
+	return o.Status() == SHIPPING_METHOD_STATUS_ACTIVE
+}
+
+// Quote returns the shipping price for an order with the given subtotal and
+// total weight in grams. Returns false if the method cannot ship the order,
+// i.e. the order is in another currency or below the first rate band.
+func (o *ShippingMethod) Quote(subtotal Money, weightGrams int64) (Money, bool) {
+	zero := NewMoney(0, o.Currency())
+
+	if subtotal.Currency() != o.Currency() {
+		return zero, false
+	}
+
+	price := zero
+
+	switch o.Type() {
+	case SHIPPING_METHOD_TYPE_FLAT:
+		price = o.PriceMoney()
+	case SHIPPING_METHOD_TYPE_WEIGHT, SHIPPING_METHOD_TYPE_PRICE:
+		value := lo.Ternary(o.Type() == SHIPPING_METHOD_TYPE_WEIGHT, weightGrams, subtotal.Amount())
+		decimals := lo.Ternary(o.Type() == SHIPPING_METHOD_TYPE_WEIGHT, WEIGHT_DECIMALS, MONEY_DECIMALS)
+
+		bandPrice, found := o.rateBandPrice(value, decimals)
+
+		if !found {
+			return zero, false
+		}
+
+		price = bandPrice
+	default:
+		return zero, false
+	}
+
+	threshold := o.FreeShippingThresholdMoney()
+
+	if threshold.IsPositive() && subtotal.Cmp(threshold) >= 0 {
+		return zero, true
+	}
+
+	return price, true
+}
+
+// rateBandPrice returns the price of the band the value falls in,
+// the value and the band froms being scaled by the decimals
+func (o *ShippingMethod) rateBandPrice(value int64, decimals int) (Money, bool) {
+	bands, err := o.RateBands()
+
+	if err != nil {
+		return Money{}, false
+	}
+
+	type band struct {
+		from  int64
+		price Money
+	}
+
+	parsed := []band{}
+
+	for _, rateBand := range bands {
+		from, err := decimalParse(rateBand.From, decimals)
+
+		if err != nil {
+			return Money{}, false
+		}
+
+		price, err := NewMoneyFromString(rateBand.Price, o.Currency())
+
+		if err != nil {
+			return Money{}, false
+		}
+
+		parsed = append(parsed, band{from: from, price: price})
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[i].from < parsed[j].from
+	})
+
+	found := false
+	price := Money{}
+
+	for _, band := range parsed {
+		if value < band.from {
+			break
+		}
+
+		price = band.price
+		found = true
+	}
+
+	return price, found
+}
+
+// == SETTERS AND GETTERS ====================================================
+
+func (o *ShippingMethod) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *ShippingMethod) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt())
+}
+
+func (o *ShippingMethod) SetCreatedAt(createdAt string) ShippingMethodInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *ShippingMethod) Currency() string {
+	return o.Get(COLUMN_CURRENCY)
+}
+
+func (o *ShippingMethod) SetCurrency(currency string) ShippingMethodInterface {
+	o.Set(COLUMN_CURRENCY, currency)
+	return o
+}
+
+func (o *ShippingMethod) Description() string {
+	return o.Get(COLUMN_DESCRIPTION)
+}
+
+func (o *ShippingMethod) SetDescription(description string) ShippingMethodInterface {
+	o.Set(COLUMN_DESCRIPTION, description)
+	return o
+}
+
+// FreeShippingThreshold returns the order subtotal from which the shipping
+// is free, zero for no free shipping
+func (o *ShippingMethod) FreeShippingThreshold() string {
+	return o.Get(COLUMN_FREE_SHIPPING_THRESHOLD)
+}
+
+func (o *ShippingMethod) SetFreeShippingThreshold(threshold string) ShippingMethodInterface {
+	o.Set(COLUMN_FREE_SHIPPING_THRESHOLD, threshold)
+	return o
+}
+
+func (o *ShippingMethod) FreeShippingThresholdMoney() Money {
+	threshold, err := NewMoneyFromString(o.FreeShippingThreshold(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return threshold
+}
+
+func (o *ShippingMethod) SetFreeShippingThresholdMoney(threshold Money) ShippingMethodInterface {
+	return o.SetFreeShippingThreshold(threshold.String())
+}
+
+func (o *ShippingMethod) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *ShippingMethod) SetID(id string) ShippingMethodInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *ShippingMethod) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *ShippingMethod) SetMemo(memo string) ShippingMethodInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *ShippingMethod) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *ShippingMethod) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *ShippingMethod) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *ShippingMethod) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *ShippingMethod) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+// Price returns the price of a flat rate shipping method
+func (o *ShippingMethod) Price() string {
+	return o.Get(COLUMN_PRICE)
+}
+
+func (o *ShippingMethod) SetPrice(price string) ShippingMethodInterface {
+	o.Set(COLUMN_PRICE, price)
+	return o
+}
+
+func (o *ShippingMethod) PriceMoney() Money {
+	price, err := NewMoneyFromString(o.Price(), o.Currency())
+
+	if err != nil {
+		return NewMoney(0, o.Currency())
+	}
+
+	return price
+}
+
+// SetPriceMoney sets the price and the currency of the shipping method
+func (o *ShippingMethod) SetPriceMoney(price Money) ShippingMethodInterface {
+	o.SetPrice(price.String())
+	o.SetCurrency(price.Currency())
+	return o
+}
+
+// RateBands returns the rate bands of a weight or price banded shipping method
+func (o *ShippingMethod) RateBands() ([]ShippingRateBand, error) {
+	bandsStr := o.Get(COLUMN_RATE_BANDS)
+
+	if bandsStr == "" {
+		return []ShippingRateBand{}, nil
+	}
+
+	bands := []ShippingRateBand{}
+
+	if err := json.Unmarshal([]byte(bandsStr), &bands); err != nil {
+		return []ShippingRateBand{}, err
+	}
+
+	return bands, nil
+}
+
+// SetRateBands stores the rate bands as json string
+func (o *ShippingMethod) SetRateBands(bands []ShippingRateBand) error {
+	bandsJson, err := json.Marshal(bands)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_RATE_BANDS, string(bandsJson))
+
+	return nil
+}
+
+func (o *ShippingMethod) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *ShippingMethod) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt())
+}
+
+func (o *ShippingMethod) SetSoftDeletedAt(deletedAt string) ShippingMethodInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, deletedAt)
+	return o
+}
+
+func (o *ShippingMethod) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *ShippingMethod) SetStatus(status string) ShippingMethodInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *ShippingMethod) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *ShippingMethod) SetTitle(title string) ShippingMethodInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+// Type returns one of the SHIPPING_METHOD_TYPE_* constants
+func (o *ShippingMethod) Type() string {
+	return o.Get(COLUMN_TYPE)
+}
+
+func (o *ShippingMethod) SetType(type_ string) ShippingMethodInterface {
+	o.Set(COLUMN_TYPE, type_)
+	return o
+}
+
+func (o *ShippingMethod) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *ShippingMethod) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt())
+}
+
+func (o *ShippingMethod) SetUpdatedAt(updatedAt string) ShippingMethodInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
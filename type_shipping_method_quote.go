@@ -0,0 +1,10 @@
+package shopstore
+
+// == CLASS ====================================================================
+
+// ShippingMethodQuote is a shipping method available for an order together
+// with its price for the order, as returned by Store.ShippingQuote
+type ShippingMethodQuote struct {
+	ShippingMethod ShippingMethodInterface
+	Price          Money
+}
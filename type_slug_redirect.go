@@ -0,0 +1,94 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+)
+
+// == CONSTANTS ================================================================
+
+const SLUG_REDIRECT_ENTITY_TYPE_CATEGORY = "category"
+const SLUG_REDIRECT_ENTITY_TYPE_PRODUCT = "product"
+
+// == CLASS ====================================================================
+
+// SlugRedirect is a slug a product or category had before its slug was
+// changed, so links with the old slug still find it (see ProductFindBySlug)
+type SlugRedirect struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ SlugRedirectInterface = (*SlugRedirect)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewSlugRedirect() SlugRedirectInterface {
+	o := (&SlugRedirect{}).
+		SetID(uid.HumanUid()).
+		SetEntityType("").
+		SetEntityID("").
+		SetSlug("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewSlugRedirectFromExistingData(data map[string]string) SlugRedirectInterface {
+	o := &SlugRedirect{}
+	o.Hydrate(data)
+	return o
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *SlugRedirect) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *SlugRedirect) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *SlugRedirect) SetCreatedAt(createdAt string) SlugRedirectInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *SlugRedirect) EntityID() string {
+	return o.Get(COLUMN_ENTITY_ID)
+}
+
+func (o *SlugRedirect) SetEntityID(entityID string) SlugRedirectInterface {
+	o.Set(COLUMN_ENTITY_ID, entityID)
+	return o
+}
+
+func (o *SlugRedirect) EntityType() string {
+	return o.Get(COLUMN_ENTITY_TYPE)
+}
+
+func (o *SlugRedirect) SetEntityType(entityType string) SlugRedirectInterface {
+	o.Set(COLUMN_ENTITY_TYPE, entityType)
+	return o
+}
+
+func (o *SlugRedirect) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *SlugRedirect) SetID(id string) SlugRedirectInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *SlugRedirect) Slug() string {
+	return o.Get(COLUMN_SLUG)
+}
+
+func (o *SlugRedirect) SetSlug(slug string) SlugRedirectInterface {
+	o.Set(COLUMN_SLUG, slug)
+	return o
+}
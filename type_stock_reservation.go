@@ -0,0 +1,176 @@
+package shopstore
+
+import (
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/uid"
+	"github.com/spf13/cast"
+)
+
+// == CONSTANTS ================================================================
+
+// The reservation holds the quantity of the product for the order
+const STOCK_RESERVATION_STATUS_ACTIVE = "active"
+
+// The order was paid, the quantity was taken from the product stock
+const STOCK_RESERVATION_STATUS_CONSUMED = "consumed"
+
+// The order was cancelled, or the reservation released by hand
+const STOCK_RESERVATION_STATUS_RELEASED = "released"
+
+// The reservation expired before the order was paid
+const STOCK_RESERVATION_STATUS_EXPIRED = "expired"
+
+// STOCK_RESERVATION_MINUTES is the default number of minutes
+// a reservation holds the stock for
+const STOCK_RESERVATION_MINUTES = 15
+
+// == CLASS ====================================================================
+
+// StockReservation holds a quantity of a product for a pending order,
+// so it cannot be sold to anyone else until the order is paid or the
+// reservation expires (see AvailableQuantity)
+type StockReservation struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES ===============================================================
+
+var _ StockReservationInterface = (*StockReservation)(nil)
+
+// == CONSTRUCTORS =============================================================
+
+func NewStockReservation() StockReservationInterface {
+	o := (&StockReservation{}).
+		SetID(uid.HumanUid()).
+		SetStatus(STOCK_RESERVATION_STATUS_ACTIVE).
+		SetProductID("").
+		SetOrderID("").
+		SetVariantID("").
+		SetQuantityInt(1). // By default 1
+		SetExpiresAt(carbon.Now(carbon.UTC).AddMinutes(STOCK_RESERVATION_MINUTES).ToDateTimeString(carbon.UTC)).
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC))
+
+	return o
+}
+
+func NewStockReservationFromExistingData(data map[string]string) StockReservationInterface {
+	o := &StockReservation{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ==================================================================
+
+func (o *StockReservation) IsActive() bool {
+	return o.Status() == STOCK_RESERVATION_STATUS_ACTIVE
+}
+
+// IsExpired returns true if the expiry time of the reservation has passed
+func (o *StockReservation) IsExpired() bool {
+	return o.ExpiresAtCarbon().Lte(carbon.Now(carbon.UTC))
+}
+
+// == SETTERS AND GETTERS ======================================================
+
+func (o *StockReservation) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *StockReservation) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt(), carbon.UTC)
+}
+
+func (o *StockReservation) SetCreatedAt(createdAt string) StockReservationInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *StockReservation) ExpiresAt() string {
+	return o.Get(COLUMN_EXPIRES_AT)
+}
+
+func (o *StockReservation) ExpiresAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.ExpiresAt(), carbon.UTC)
+}
+
+func (o *StockReservation) SetExpiresAt(expiresAt string) StockReservationInterface {
+	o.Set(COLUMN_EXPIRES_AT, expiresAt)
+	return o
+}
+
+func (o *StockReservation) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *StockReservation) SetID(id string) StockReservationInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+func (o *StockReservation) OrderID() string {
+	return o.Get(COLUMN_ORDER_ID)
+}
+
+func (o *StockReservation) SetOrderID(orderID string) StockReservationInterface {
+	o.Set(COLUMN_ORDER_ID, orderID)
+	return o
+}
+
+func (o *StockReservation) ProductID() string {
+	return o.Get(COLUMN_PRODUCT_ID)
+}
+
+func (o *StockReservation) SetProductID(productID string) StockReservationInterface {
+	o.Set(COLUMN_PRODUCT_ID, productID)
+	return o
+}
+
+func (o *StockReservation) Quantity() string {
+	return o.Get(COLUMN_QUANTITY)
+}
+
+func (o *StockReservation) SetQuantity(quantity string) StockReservationInterface {
+	o.Set(COLUMN_QUANTITY, quantity)
+	return o
+}
+
+func (o *StockReservation) QuantityInt() int64 {
+	return cast.ToInt64(o.Quantity())
+}
+
+func (o *StockReservation) SetQuantityInt(quantity int64) StockReservationInterface {
+	return o.SetQuantity(cast.ToString(quantity))
+}
+
+func (o *StockReservation) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *StockReservation) SetStatus(status string) StockReservationInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *StockReservation) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *StockReservation) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt(), carbon.UTC)
+}
+
+func (o *StockReservation) SetUpdatedAt(updatedAt string) StockReservationInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}
+
+func (o *StockReservation) VariantID() string {
+	return o.Get(COLUMN_VARIANT_ID)
+}
+
+func (o *StockReservation) SetVariantID(variantID string) StockReservationInterface {
+	o.Set(COLUMN_VARIANT_ID, variantID)
+	return o
+}
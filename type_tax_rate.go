@@ -0,0 +1,283 @@
+package shopstore
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/gouniverse/dataobject"
+	"github.com/gouniverse/maputils"
+	"github.com/gouniverse/sb"
+	"github.com/gouniverse/uid"
+	"github.com/gouniverse/utils"
+)
+
+// == CONSTANTS ==============================================================
+
+const TAX_RATE_STATUS_DRAFT = "draft"
+const TAX_RATE_STATUS_ACTIVE = "active"
+const TAX_RATE_STATUS_INACTIVE = "inactive"
+
+// TAX_RATE_DECIMALS is the number of decimals the rate percent is stored
+// with, i.e. 8.8750 for a sales tax of 8.875%
+const TAX_RATE_DECIMALS = 4
+
+// == CLASS ==================================================================
+
+// TaxRate is the tax percent charged in a region, either for all products
+// (empty category) or only for the products of a category and its subcategories.
+type TaxRate struct {
+	dataobject.DataObject
+}
+
+// == INTERFACES =============================================================
+
+var _ TaxRateInterface = (*TaxRate)(nil)
+
+// == CONSTRUCTORS ===========================================================
+
+func NewTaxRate() TaxRateInterface {
+	o := (&TaxRate{}).
+		SetID(uid.HumanUid()).
+		SetStatus(TAX_RATE_STATUS_DRAFT).
+		SetTitle("").
+		SetRegion("").
+		SetCategoryID(""). // By default all categories
+		SetRateFloat(0).
+		SetInclusive(false). // By default added on top of the price
+		SetMemo("").
+		SetCreatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetUpdatedAt(carbon.Now(carbon.UTC).ToDateTimeString(carbon.UTC)).
+		SetSoftDeletedAt(sb.MAX_DATETIME)
+
+	_ = o.SetMetas(map[string]string{})
+
+	return o
+}
+
+func NewTaxRateFromExistingData(data map[string]string) TaxRateInterface {
+	o := &TaxRate{}
+	o.Hydrate(data)
+	return o
+}
+
+// == METHODS ================================================================
+
+// IsActive returns true if the tax rate is active
+func (o *TaxRate) IsActive() bool {
+	return o.Status() == TAX_RATE_STATUS_ACTIVE
+}
+
+// Calculate returns the tax the rate gives on the amount. For inclusive
+// rates the tax is the part of the amount that is tax, otherwise the
+// tax is added on top of the amount.
+func (o *TaxRate) Calculate(amount Money) Money {
+	rate, err := decimalParse(o.Rate(), TAX_RATE_DECIMALS)
+
+	if err != nil || rate <= 0 {
+		return NewMoney(0, amount.Currency())
+	}
+
+	// 100% expressed in the rate decimals
+	hundred := int64(100) * int64(math.Pow10(TAX_RATE_DECIMALS))
+
+	if o.IsInclusive() {
+		return NewMoney(divRound(amount.Amount()*rate, hundred+rate), amount.Currency())
+	}
+
+	return NewMoney(divRound(amount.Amount()*rate, hundred), amount.Currency())
+}
+
+// == SETTERS AND GETTERS ====================================================
+
+// CategoryID returns the category the tax rate applies to, empty for all categories
+func (o *TaxRate) CategoryID() string {
+	return o.Get(COLUMN_CATEGORY_ID)
+}
+
+func (o *TaxRate) SetCategoryID(categoryID string) TaxRateInterface {
+	o.Set(COLUMN_CATEGORY_ID, categoryID)
+	return o
+}
+
+func (o *TaxRate) CreatedAt() string {
+	return o.Get(COLUMN_CREATED_AT)
+}
+
+func (o *TaxRate) CreatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.CreatedAt())
+}
+
+func (o *TaxRate) SetCreatedAt(createdAt string) TaxRateInterface {
+	o.Set(COLUMN_CREATED_AT, createdAt)
+	return o
+}
+
+func (o *TaxRate) ID() string {
+	return o.Get(COLUMN_ID)
+}
+
+func (o *TaxRate) SetID(id string) TaxRateInterface {
+	o.Set(COLUMN_ID, id)
+	return o
+}
+
+// IsInclusive returns true if the tax is included in the prices,
+// false if the tax is added on top of the prices
+func (o *TaxRate) IsInclusive() bool {
+	return o.Get(COLUMN_INCLUSIVE) == "yes"
+}
+
+func (o *TaxRate) SetInclusive(inclusive bool) TaxRateInterface {
+	if inclusive {
+		o.Set(COLUMN_INCLUSIVE, "yes")
+	} else {
+		o.Set(COLUMN_INCLUSIVE, "no")
+	}
+	return o
+}
+
+func (o *TaxRate) Memo() string {
+	return o.Get(COLUMN_MEMO)
+}
+
+func (o *TaxRate) SetMemo(memo string) TaxRateInterface {
+	o.Set(COLUMN_MEMO, memo)
+	return o
+}
+
+func (o *TaxRate) Meta(name string) string {
+	metas, err := o.Metas()
+
+	if err != nil {
+		return ""
+	}
+
+	if value, exists := metas[name]; exists {
+		return value
+	}
+
+	return ""
+}
+
+func (o *TaxRate) SetMeta(name string, value string) error {
+	return o.UpsertMetas(map[string]string{name: value})
+}
+
+func (o *TaxRate) Metas() (map[string]string, error) {
+	metasStr := o.Get(COLUMN_METAS)
+
+	if metasStr == "" {
+		metasStr = "{}"
+	}
+
+	metasJson, errJson := utils.FromJSON(metasStr, map[string]string{})
+	if errJson != nil {
+		return map[string]string{}, errJson
+	}
+
+	return maputils.MapStringAnyToMapStringString(metasJson.(map[string]any)), nil
+}
+
+// SetMetas stores metas as json string
+// Warning: it overwrites any existing metas
+func (o *TaxRate) SetMetas(metas map[string]string) error {
+	mapString, err := utils.ToJSON(metas)
+
+	if err != nil {
+		return err
+	}
+
+	o.Set(COLUMN_METAS, mapString)
+
+	return nil
+}
+
+func (o *TaxRate) UpsertMetas(metas map[string]string) error {
+	currentMetas, err := o.Metas()
+
+	if err != nil {
+		return err
+	}
+
+	for k, v := range metas {
+		currentMetas[k] = v
+	}
+
+	return o.SetMetas(currentMetas)
+}
+
+// Rate returns the tax percent, i.e. "20.0000" for 20%
+func (o *TaxRate) Rate() string {
+	return o.Get(COLUMN_RATE)
+}
+
+func (o *TaxRate) SetRate(rate string) TaxRateInterface {
+	o.Set(COLUMN_RATE, rate)
+	return o
+}
+
+func (o *TaxRate) RateFloat() float64 {
+	rate, _ := utils.ToFloat(o.Rate())
+	return rate
+}
+
+func (o *TaxRate) SetRateFloat(rate float64) TaxRateInterface {
+	return o.SetRate(strconv.FormatFloat(rate, 'f', TAX_RATE_DECIMALS, 64))
+}
+
+// Region returns the region the tax rate applies to, i.e. a country
+// code ("GB") or a country subdivision code ("US-CA")
+func (o *TaxRate) Region() string {
+	return o.Get(COLUMN_REGION)
+}
+
+func (o *TaxRate) SetRegion(region string) TaxRateInterface {
+	o.Set(COLUMN_REGION, strings.ToUpper(strings.TrimSpace(region)))
+	return o
+}
+
+func (o *TaxRate) SoftDeletedAt() string {
+	return o.Get(COLUMN_SOFT_DELETED_AT)
+}
+
+func (o *TaxRate) SoftDeletedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.SoftDeletedAt())
+}
+
+func (o *TaxRate) SetSoftDeletedAt(deletedAt string) TaxRateInterface {
+	o.Set(COLUMN_SOFT_DELETED_AT, deletedAt)
+	return o
+}
+
+func (o *TaxRate) Status() string {
+	return o.Get(COLUMN_STATUS)
+}
+
+func (o *TaxRate) SetStatus(status string) TaxRateInterface {
+	o.Set(COLUMN_STATUS, status)
+	return o
+}
+
+func (o *TaxRate) Title() string {
+	return o.Get(COLUMN_TITLE)
+}
+
+func (o *TaxRate) SetTitle(title string) TaxRateInterface {
+	o.Set(COLUMN_TITLE, title)
+	return o
+}
+
+func (o *TaxRate) UpdatedAt() string {
+	return o.Get(COLUMN_UPDATED_AT)
+}
+
+func (o *TaxRate) UpdatedAtCarbon() *carbon.Carbon {
+	return carbon.Parse(o.UpdatedAt())
+}
+
+func (o *TaxRate) SetUpdatedAt(updatedAt string) TaxRateInterface {
+	o.Set(COLUMN_UPDATED_AT, updatedAt)
+	return o
+}